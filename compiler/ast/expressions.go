@@ -46,6 +46,8 @@ func (il *FloatLiteral) String() string {
 type StringLiteral struct {
 	*BaseNode
 	Value string
+	// IsSymbol is true for symbol literals like `:foo`, which are frozen strings
+	IsSymbol bool
 }
 
 // Define the string literal which contains the node expression and its value
@@ -66,6 +68,24 @@ func (sl *StringLiteral) String() string {
 	return out.String()
 }
 
+// RegexpLiteral contains the node expression and its pattern
+type RegexpLiteral struct {
+	*BaseNode
+	Value string
+}
+
+func (rl *RegexpLiteral) expressionNode() {}
+
+// RegexpLiteral.TokenLiteral gets the literal of the Regexp type token
+func (rl *RegexpLiteral) TokenLiteral() string {
+	return rl.Token.Literal
+}
+
+// RegexpLiteral.String gets the string format of the Regexp type token
+func (rl *RegexpLiteral) String() string {
+	return "/" + rl.Token.Literal + "/"
+}
+
 // ArrayExpression defines the array expression literal which contains the node expression and its value
 type ArrayExpression struct {
 	*BaseNode
@@ -128,6 +148,8 @@ func (ape *ArgumentPairExpression) String() string {
 type HashExpression struct {
 	*BaseNode
 	Data map[string]Expression
+	// Keys holds the keys of Data in the order they appear in the literal
+	Keys []string
 }
 
 func (he *HashExpression) expressionNode() {}
@@ -142,8 +164,8 @@ func (he *HashExpression) String() string {
 	var out bytes.Buffer
 	var pairs []string
 
-	for key, value := range he.Data {
-		pairs = append(pairs, fmt.Sprintf("%s: %s", key, value.String()))
+	for _, key := range he.Keys {
+		pairs = append(pairs, fmt.Sprintf("%s: %s", key, he.Data[key].String()))
 	}
 
 	out.WriteString("{")
@@ -262,10 +284,12 @@ func (n *NilExpression) String() string {
 	return "nil"
 }
 
+// IfExpression is also used for `unless`, which only has one conditional with an inverted condition
 type IfExpression struct {
 	*BaseNode
 	Conditionals []*ConditionalExpression
 	Alternative  *BlockStatement
+	Unless       bool
 }
 
 func (ie *IfExpression) expressionNode() {}
@@ -275,8 +299,24 @@ func (ie *IfExpression) TokenLiteral() string {
 func (ie *IfExpression) String() string {
 	var out bytes.Buffer
 
+	// Ternary expression like `a ? b : c`
+	if ie.TokenLiteral() == "?" {
+		out.WriteString("(")
+		out.WriteString(ie.Conditionals[0].Condition.String())
+		out.WriteString(" ? ")
+		out.WriteString(ie.Conditionals[0].Consequence.String())
+		out.WriteString(" : ")
+		out.WriteString(ie.Alternative.String())
+		out.WriteString(")")
+
+		return out.String()
+	}
+
 	for i, c := range ie.Conditionals {
-		if i == 0 {
+		if ie.Unless {
+			out.WriteString("unless")
+			out.WriteString(" ")
+		} else if i == 0 {
 			out.WriteString("if")
 			out.WriteString(" ")
 		} else {
@@ -431,6 +471,8 @@ type RangeExpression struct {
 	*BaseNode
 	Start Expression
 	End   Expression
+	// Exclusive is true for the `...` literal, which doesn't include the end
+	Exclusive bool
 }
 
 func (re *RangeExpression) expressionNode() {}
@@ -446,7 +488,7 @@ func (re *RangeExpression) String() string {
 
 	out.WriteString("(")
 	out.WriteString(re.Start.String())
-	out.WriteString("..")
+	out.WriteString(re.TokenLiteral())
 	out.WriteString(re.End.String())
 	out.WriteString(")")
 
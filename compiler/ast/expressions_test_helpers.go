@@ -106,6 +106,13 @@ func (b *BaseNode) IsIntegerLiteral(t *testing.T) (il *TestableIntegerLiteral) {
 	return
 }
 
+// IsRegexpLiteral fails the test and returns nil by default
+func (b *BaseNode) IsRegexpLiteral(t *testing.T) *TestableRegexpLiteral {
+	t.Helper()
+	t.Fatalf(nodeFailureMsgFormat, "regexp literal", b)
+	return nil
+}
+
 // IsSelfExpression fails the test and returns nil by default
 func (b *BaseNode) IsSelfExpression(t *testing.T) (sl *TestableSelfExpression) {
 	t.Helper()
@@ -191,6 +198,11 @@ func (il *IntegerLiteral) IsIntegerLiteral(t *testing.T) *TestableIntegerLiteral
 	return &TestableIntegerLiteral{IntegerLiteral: il, t: t}
 }
 
+// IsRegexpLiteral returns pointer of the receiver regexp literal
+func (rl *RegexpLiteral) IsRegexpLiteral(t *testing.T) *TestableRegexpLiteral {
+	return &TestableRegexpLiteral{RegexpLiteral: rl, t: t}
+}
+
 // IsSelfExpression returns pointer of the receiver self expression
 func (se *SelfExpression) IsSelfExpression(t *testing.T) *TestableSelfExpression {
 	return &TestableSelfExpression{SelfExpression: se, t: t}
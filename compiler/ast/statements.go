@@ -131,6 +131,7 @@ func (tds *DefStatement) String() string {
 // NextStatement represents "next" keyword
 type NextStatement struct {
 	*BaseNode
+	Value Expression
 }
 
 func (ns *NextStatement) statementNode() {}
@@ -140,12 +141,17 @@ func (ns *NextStatement) TokenLiteral() string {
 	return ns.Token.Literal
 }
 func (ns *NextStatement) String() string {
+	if ns.Value != nil {
+		return "next " + ns.Value.String()
+	}
+
 	return "next"
 }
 
 // BreakStatement represents "break" keyword
 type BreakStatement struct {
 	*BaseNode
+	Value Expression
 }
 
 func (bs *BreakStatement) statementNode() {}
@@ -155,13 +161,19 @@ func (bs *BreakStatement) TokenLiteral() string {
 	return bs.Token.Literal
 }
 func (bs *BreakStatement) String() string {
+	if bs.Value != nil {
+		return bs.TokenLiteral() + " " + bs.Value.String()
+	}
+
 	return bs.TokenLiteral()
 }
 
+// WhileStatement is also used for `until`, which loops while the condition is falsy
 type WhileStatement struct {
 	*BaseNode
 	Condition Expression
 	Body      *BlockStatement
+	Until     bool
 }
 
 func (ws *WhileStatement) statementNode() {}
@@ -171,7 +183,7 @@ func (ws *WhileStatement) TokenLiteral() string {
 func (ws *WhileStatement) String() string {
 	var out bytes.Buffer
 
-	out.WriteString("while ")
+	out.WriteString(ws.TokenLiteral() + " ")
 	out.WriteString(ws.Condition.String())
 	out.WriteString(" do\n")
 	out.WriteString(ws.Body.String())
@@ -208,10 +220,10 @@ func (bs *BlockStatement) KeepLastValue() {
 		return
 	}
 
-	stmt := bs.Statements[len(bs.Statements)-1]
-	expStmt, ok := stmt.(*ExpressionStatement)
-
-	if ok {
-		expStmt.Expression.MarkAsExp()
+	switch stmt := bs.Statements[len(bs.Statements)-1].(type) {
+	case *ExpressionStatement:
+		stmt.Expression.MarkAsExp()
+	case *WhileStatement:
+		stmt.MarkAsExp()
 	}
 }
@@ -20,6 +20,7 @@ type TestableExpression interface {
 	IsInfixExpression(t *testing.T) *TestableInfixExpression
 	IsInstanceVariable(t *testing.T) *TestableInstanceVariable
 	IsIntegerLiteral(t *testing.T) *TestableIntegerLiteral
+	IsRegexpLiteral(t *testing.T) *TestableRegexpLiteral
 	IsSelfExpression(t *testing.T) *TestableSelfExpression
 	IsStringLiteral(t *testing.T) *TestableStringLiteral
 	IsYieldExpression(t *testing.T) *TestableYieldExpression
@@ -256,6 +257,20 @@ type TestableSelfExpression struct {
 	t *testing.T
 }
 
+// TestableRegexpLiteral
+type TestableRegexpLiteral struct {
+	*RegexpLiteral
+	t *testing.T
+}
+
+// ShouldEqualTo compares if the regexp literal's pattern equals to the expected value
+func (trl *TestableRegexpLiteral) ShouldEqualTo(expected string) {
+	if trl.Value != expected {
+		trl.t.Helper()
+		trl.t.Fatalf("Expect regexp literal to be %s, got %s", expected, trl.Value)
+	}
+}
+
 // TestableStringLiteral
 type TestableStringLiteral struct {
 	*StringLiteral
@@ -170,7 +170,7 @@ func (tds *TestableDefStatement) ShouldHaveSplatParam(expectedName string) {
 	for _, param := range tds.Parameters {
 		p, ok := param.(*PrefixExpression)
 
-		if ok {
+		if ok && p.Operator == "*" {
 			paramName := p.Right.(*Identifier).Value
 			if expectedName == paramName {
 				return
@@ -182,6 +182,23 @@ func (tds *TestableDefStatement) ShouldHaveSplatParam(expectedName string) {
 	tds.t.Fatalf("Can't find splat param '%s' in method '%s'", expectedName, tds.Name.Value)
 }
 
+// ShouldHaveBlockParam checks if the method has expected block argument
+func (tds *TestableDefStatement) ShouldHaveBlockParam(expectedName string) {
+	for _, param := range tds.Parameters {
+		p, ok := param.(*PrefixExpression)
+
+		if ok && p.Operator == "&" {
+			paramName := p.Right.(*Identifier).Value
+			if expectedName == paramName {
+				return
+			}
+		}
+	}
+
+	tds.t.Helper()
+	tds.t.Fatalf("Can't find block param '%s' in method '%s'", expectedName, tds.Name.Value)
+}
+
 /*TestableModuleStatement*/
 
 type TestableModuleStatement struct {
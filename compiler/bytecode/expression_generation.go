@@ -21,7 +21,16 @@ func (g *Generator) compileExpression(is *InstructionSet, exp ast.Expression, sc
 	case *ast.FloatLiteral:
 		is.define(PutFloat, sourceLine, exp.Value)
 	case *ast.StringLiteral:
+		if exp.IsSymbol {
+			is.define(PutString, sourceLine, exp.Value, true)
+		} else {
+			is.define(PutString, sourceLine, exp.Value)
+		}
+	case *ast.RegexpLiteral:
+		// `/pattern/` is the same as `Regexp.new("pattern")`
+		is.define(GetConstant, sourceLine, "Regexp", false)
 		is.define(PutString, sourceLine, exp.Value)
+		is.define(Send, sourceLine, "new", 1, "", &ArgSet{names: make([]string, 1), types: make([]uint8, 1)})
 	case *ast.BooleanExpression:
 		is.define(PutBoolean, sourceLine, exp.Value)
 	case *ast.NilExpression:
@@ -29,18 +38,18 @@ func (g *Generator) compileExpression(is *InstructionSet, exp ast.Expression, sc
 	case *ast.RangeExpression:
 		g.compileExpression(is, exp.Start, scope, table)
 		g.compileExpression(is, exp.End, scope, table)
-		is.define(NewRange, sourceLine, 0)
+		is.define(NewRange, sourceLine, exp.Exclusive)
 	case *ast.ArrayExpression:
 		for _, elem := range exp.Elements {
 			g.compileExpression(is, elem, scope, table)
 		}
 		is.define(NewArray, sourceLine, len(exp.Elements))
 	case *ast.HashExpression:
-		for key, value := range exp.Data {
+		for _, key := range exp.Keys {
 			is.define(PutString, sourceLine, key)
-			g.compileExpression(is, value, scope, table)
+			g.compileExpression(is, exp.Data[key], scope, table)
 		}
-		is.define(NewHash, sourceLine, len(exp.Data)*2)
+		is.define(NewHash, sourceLine, len(exp.Keys)*2)
 	case *ast.SelfExpression:
 		is.define(PutSelf, sourceLine)
 	case *ast.ArgumentPairExpression:
@@ -92,17 +101,28 @@ func (g *Generator) compileGetBlockExpression(is *InstructionSet, exp *ast.GetBl
 }
 
 func (g *Generator) compileCallExpression(is *InstructionSet, exp *ast.CallExpression, scope *scope, table *localTable) {
+	if infix, ok := elementOperation(exp); ok {
+		g.compileElementOperation(is, exp, infix, scope, table)
+		return
+	}
+
 	var blockInfo string
+	args := exp.Arguments
+	blockArg, hasBlockArg := blockArgument(args)
+	if hasBlockArg {
+		args = args[:len(args)-1]
+	}
+
 	argSet := &ArgSet{
-		names: make([]string, len(exp.Arguments)),
-		types: make([]uint8, len(exp.Arguments)),
+		names: make([]string, len(args)),
+		types: make([]uint8, len(args)),
 	}
 
 	// Compile receiver
 	g.compileExpression(is, exp.Receiver, scope, table)
 
 	// Compile arguments
-	for i, arg := range exp.Arguments {
+	for i, arg := range args {
 		switch arg := arg.(type) {
 		case *ast.Identifier:
 			argSet.setArg(i, arg.Value, NormalArg)
@@ -130,7 +150,11 @@ func (g *Generator) compileCallExpression(is *InstructionSet, exp *ast.CallExpre
 	}
 
 	// Compile block
-	if exp.Block != nil {
+	if hasBlockArg {
+		// `foo(&block)` passes the object on top of the arguments as the block
+		g.compileExpression(is, blockArg.Right, scope, table)
+		blockInfo = BlockArgFlag
+	} else if exp.Block != nil {
 		// Inside block should be one level deeper than outside
 		newTable := newLocalTable(table.depth + 1)
 		newTable.upper = table
@@ -140,10 +164,106 @@ func (g *Generator) compileCallExpression(is *InstructionSet, exp *ast.CallExpre
 		g.compileBlockArgExpression(blockIndex, exp, scope, newTable)
 	}
 
-	is.define(Send, exp.Line(), exp.Method, len(exp.Arguments), blockInfo, argSet)
+	is.define(Send, exp.Line(), exp.Method, len(args), blockInfo, argSet)
+}
+
+// blockArgument returns the trailing `&block` of the given arguments or parameters
+func blockArgument(args []ast.Expression) (*ast.PrefixExpression, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+
+	prefix, ok := args[len(args)-1].(*ast.PrefixExpression)
+	if !ok || prefix.Operator != "&" {
+		return nil, false
+	}
+
+	return prefix, true
+}
+
+// elementOperation returns the operation of an expanded `a[i] += b`, which the parser turns into `a[i] = a[i] + b`
+// with `a` and `i` shared by both calls.
+func elementOperation(exp *ast.CallExpression) (*ast.InfixExpression, bool) {
+	if exp.Method != "[]=" || len(exp.Arguments) != 2 {
+		return nil, false
+	}
+
+	infix, ok := exp.Arguments[1].(*ast.InfixExpression)
+	if !ok {
+		return nil, false
+	}
+
+	get, ok := infix.Left.(*ast.CallExpression)
+	if !ok || get.Method != "[]" || len(get.Arguments) != 1 {
+		return nil, false
+	}
+
+	return infix, get.Receiver == exp.Receiver && get.Arguments[0] == exp.Arguments[0]
+}
+
+// compileElementOperation evaluates the receiver and the index only once, then reuses them for both `[]` and `[]=`
+func (g *Generator) compileElementOperation(is *InstructionSet, exp *ast.CallExpression, infix *ast.InfixExpression, scope *scope, table *localTable) {
+	g.compileExpression(is, exp.Receiver, scope, table)
+	g.compileExpression(is, exp.Arguments[0], scope, table)
+	is.define(Dup, exp.Line(), 2)
+	is.define(Send, exp.Line(), "[]", 1, "", &ArgSet{})
+	g.compileExpression(is, infix.Right, scope, table)
+	is.define(Send, infix.Line(), infix.Operator, 1, "", &ArgSet{})
+	is.define(Send, exp.Line(), exp.Method, 2, "", &ArgSet{})
+}
+
+// elementLogicalAssignment returns the write of an expanded `a[i] ||= b`, which the parser turns into `a[i] || a[i] = b`
+// with `a` and `i` shared by both calls.
+func elementLogicalAssignment(node *ast.InfixExpression) (*ast.CallExpression, bool) {
+	if node.Operator != "||" && node.Operator != "&&" {
+		return nil, false
+	}
+
+	get, ok := node.Left.(*ast.CallExpression)
+	if !ok || get.Method != "[]" || len(get.Arguments) != 1 {
+		return nil, false
+	}
+
+	set, ok := node.Right.(*ast.CallExpression)
+	if !ok || set.Method != "[]=" || len(set.Arguments) != 2 {
+		return nil, false
+	}
+
+	return set, get.Receiver == set.Receiver && get.Arguments[0] == set.Arguments[0]
+}
+
+// compileElementLogicalAssignment evaluates the receiver and the index only once, and only calls `[]=` when the element needs to be written
+func (g *Generator) compileElementLogicalAssignment(is *InstructionSet, node *ast.InfixExpression, set *ast.CallExpression, scope *scope, table *localTable) {
+	doneAnchor := &anchor{}
+	branch := BranchIf
+
+	if node.Operator == "&&" {
+		branch = BranchUnless
+	}
+
+	g.compileExpression(is, set.Receiver, scope, table)
+	g.compileExpression(is, set.Arguments[0], scope, table)
+	is.define(Dup, node.Line(), 2)
+	is.define(Send, node.Line(), "[]", 1, "", &ArgSet{})
+	is.define(Dup, node.Line())
+	b := is.define(branch, node.Line(), doneAnchor)
+	g.instructionsWithAnchor = append(g.instructionsWithAnchor, b)
+	is.define(Pop, node.Line())
+	is.define(Dup, node.Line(), 2)
+	g.compileExpression(is, set.Arguments[1], scope, table)
+	is.define(Send, set.Line(), set.Method, 2, "", &ArgSet{})
+	doneAnchor.line = len(is.Instructions)
+	// Only the result is left, the receiver and the index under it are removed
+	is.define(Pop, node.Line(), 2)
 }
 
 func (g *Generator) compileAssignExpression(is *InstructionSet, exp *ast.AssignExpression, scope *scope, table *localTable) {
+	// The variable is defined before its value, so `a ||= 1` works even if `a` is not defined yet.
+	// `_` is never defined, since its value is discarded.
+	if ident, ok := exp.Variables[0].(*ast.Identifier); ok && len(exp.Variables) == 1 && exp.Optioned == 0 && ident.Value != "_" {
+		table.setLCL(ident.Value, table.depth)
+	}
+
 	g.compileExpression(is, exp.Value, scope, table)
 
 	if len(exp.Variables) > 1 {
@@ -188,12 +308,27 @@ func (g *Generator) compileBlockArgExpression(index int, exp *ast.CallExpression
 	is := &InstructionSet{}
 	is.name = fmt.Sprint(index)
 	is.isType = Block
+	is.argTypes = &ArgSet{
+		names: make([]string, len(exp.BlockArguments)),
+		types: make([]uint8, len(exp.BlockArguments)),
+	}
 
 	for i := 0; i < len(exp.BlockArguments); i++ {
 		table.set(exp.BlockArguments[i].Value)
+		is.argTypes.setArg(i, exp.BlockArguments[i].Value, NormalArg)
 	}
 
+	// `next` and `break` inside the block don't belong to the outer while loop
+	outerNextAnchor := scope.anchors["next"]
+	outerBreakAnchor := scope.anchors["break"]
+	scope.anchors["next"] = nil
+	scope.anchors["break"] = nil
+
 	g.compileCodeBlock(is, exp.Block, scope, table)
+
+	scope.anchors["next"] = outerNextAnchor
+	scope.anchors["break"] = outerBreakAnchor
+
 	g.endInstructions(is, exp.Line())
 	g.instructionSets = append(g.instructionSets, is)
 }
@@ -205,7 +340,13 @@ func (g *Generator) compileIfExpression(is *InstructionSet, exp *ast.IfExpressio
 		anchorConditional := &anchor{}
 
 		g.compileExpression(is, c.Condition, scope, table)
-		bu := is.define(BranchUnless, exp.Line(), anchorConditional)
+
+		branch := BranchUnless
+		if exp.Unless {
+			branch = BranchIf
+		}
+
+		bu := is.define(branch, exp.Line(), anchorConditional)
 		g.instructionsWithAnchor = append(g.instructionsWithAnchor, bu)
 
 		if c.Consequence.IsEmpty() {
@@ -248,6 +389,11 @@ func (g *Generator) compilePrefixExpression(is *InstructionSet, exp *ast.PrefixE
 }
 
 func (g *Generator) compileInfixExpression(is *InstructionSet, node *ast.InfixExpression, scope *scope, table *localTable) {
+	if set, ok := elementLogicalAssignment(node); ok {
+		g.compileElementLogicalAssignment(is, node, set, scope, table)
+		return
+	}
+
 	switch node.Operator {
 	case "::":
 		g.compileExpression(is, node.Left, scope, table)
@@ -13,6 +13,9 @@ const (
 	Program   = "ProgramStart"
 )
 
+// BlockArgFlag is the block info of a `Send` whose block is given as an object, like `foo(&block)`
+const BlockArgFlag = "&"
+
 // instruction actions
 const (
 	GetLocal uint8 = iota
@@ -145,6 +148,11 @@ type ArgSet struct {
 	types []uint8
 }
 
+// NewArgSet returns an *ArgSet with given argument names and types
+func NewArgSet(names []string, types []uint8) *ArgSet {
+	return &ArgSet{names: names, types: types}
+}
+
 // Types are the getter method of *ArgSet's types attribute
 // TODO: needs to change the func to simple public variable
 func (as *ArgSet) Types() []uint8 {
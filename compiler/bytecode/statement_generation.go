@@ -65,10 +65,15 @@ func (g *Generator) compileStatement(is *InstructionSet, statement ast.Statement
 		g.endInstructions(is, stmt.Line())
 	case *ast.WhileStatement:
 		g.compileWhileStmt(is, stmt, scope, table)
+
+		// The loop's value is only kept when it's the last statement of a method or block
+		if !g.REPL && stmt.IsStmt() {
+			is.define(Pop, statement.Line())
+		}
 	case *ast.NextStatement:
-		g.compileNextStatement(is, stmt, scope)
+		g.compileNextStatement(is, stmt, scope, table)
 	case *ast.BreakStatement:
-		g.compileBreakStatement(is, stmt, scope)
+		g.compileBreakStatement(is, stmt, scope, table)
 	}
 }
 
@@ -98,44 +103,67 @@ func (g *Generator) compileWhileStmt(is *InstructionSet, stmt *ast.WhileStatemen
 
 	g.compileExpression(is, stmt.Condition, scope, table)
 
-	bi := is.define(BranchIf, stmt.Line(), anchor2)
+	branch := BranchIf
+	if stmt.Until {
+		branch = BranchUnless
+	}
+
+	bi := is.define(branch, stmt.Line(), anchor2)
 	g.instructionsWithAnchor = append(g.instructionsWithAnchor, bi)
 
+	// The loop evaluates to nil unless it's stopped by `break` with a value
+	is.define(PutNull, stmt.Line())
+
 	breakAnchor.line = is.count
 }
 
-func (g *Generator) compileNextStatement(is *InstructionSet, stmt ast.Statement, scope *scope) {
-	jp := is.define(Jump, stmt.Line(), scope.anchors["next"])
-	g.instructionsWithAnchor = append(g.instructionsWithAnchor, jp)
+func (g *Generator) compileNextStatement(is *InstructionSet, stmt *ast.NextStatement, scope *scope, table *localTable) {
+	// Inside a while loop, jump to the loop's condition
+	if scope.anchors["next"] != nil {
+		jp := is.define(Jump, stmt.Line(), scope.anchors["next"])
+		g.instructionsWithAnchor = append(g.instructionsWithAnchor, jp)
+		return
+	}
+
+	// Inside a block, leave the block with the value as the block's result
+	if is.isType == Block {
+		g.compileJumpValue(is, stmt.Value, stmt.Line(), scope, table)
+		is.define(Leave, stmt.Line())
+	}
 }
 
-func (g *Generator) compileBreakStatement(is *InstructionSet, stmt ast.Statement, scope *scope) {
-	if scope.anchors["break"] != nil {
-		/*
-			# We also need to leave current frame if it's inside block like:
-
-			x = [1, 2, 3]
-			y = 0
-
-			while y < 10 do
-			  x.each do |i|
-				y += i
-				if i == 2
-				  break <- need to escape from block so we also need break instruction
-				end
-			  end
-			end
+func (g *Generator) compileBreakStatement(is *InstructionSet, stmt *ast.BreakStatement, scope *scope, table *localTable) {
+	g.compileJumpValue(is, stmt.Value, stmt.Line(), scope, table)
 
-			y # 12
-		*/
-		if is.isType == Block {
-			is.define(Break, stmt.Line())
-		}
+	// Inside a while loop, jump out of the loop with the value as the loop's result
+	if scope.anchors["break"] != nil {
 		jp := is.define(Jump, stmt.Line(), scope.anchors["break"])
 		g.instructionsWithAnchor = append(g.instructionsWithAnchor, jp)
-	} else {
-		is.define(Break, stmt.Line())
+		return
 	}
+
+	/*
+		Inside a block, leave the block and the method call that yields it, like:
+
+		```ruby
+		x = [1, 2, 3].each do |i|
+		  if i == 2
+		    break i * 10 <- the `each` call evaluates to 20
+		  end
+		end
+		```
+	*/
+	is.define(Break, stmt.Line())
+}
+
+// compileJumpValue compiles the value of `next` or `break`, which is nil if not given.
+func (g *Generator) compileJumpValue(is *InstructionSet, value ast.Expression, sourceLine int, scope *scope, table *localTable) {
+	if value == nil {
+		is.define(PutNull, sourceLine)
+		return
+	}
+
+	g.compileExpression(is, value, scope, table)
 }
 
 func (g *Generator) compileClassStmt(is *InstructionSet, stmt *ast.ClassStatement, scope *scope, table *localTable) {
@@ -178,15 +206,23 @@ func (g *Generator) compileModuleStmt(is *InstructionSet, stmt *ast.ModuleStatem
 }
 
 func (g *Generator) compileDefStmt(is *InstructionSet, stmt *ast.DefStatement, scope *scope) {
+	params := stmt.Parameters
+
+	// The `&block` parameter isn't passed as an argument, so it's excluded from the method's parameters
+	blockParam, hasBlockParam := blockArgument(params)
+	if hasBlockParam {
+		params = params[:len(params)-1]
+	}
+
 	switch stmt.Receiver.(type) {
 	case nil:
 		is.define(PutSelf, stmt.Line())
 		is.define(PutString, stmt.Line(), stmt.Name.Value)
-		is.define(DefMethod, stmt.Line(), len(stmt.Parameters))
+		is.define(DefMethod, stmt.Line(), len(params))
 	default:
 		g.compileExpression(is, stmt.Receiver, scope, scope.localTable)
 		is.define(PutString, stmt.Line(), stmt.Name.Value)
-		is.define(DefSingletonMethod, stmt.Line(), len(stmt.Parameters))
+		is.define(DefSingletonMethod, stmt.Line(), len(params))
 	}
 
 	scope = newScope()
@@ -196,13 +232,13 @@ func (g *Generator) compileDefStmt(is *InstructionSet, stmt *ast.DefStatement, s
 		name:   stmt.Name.Value,
 		isType: MethodDef,
 		argTypes: &ArgSet{
-			names: make([]string, len(stmt.Parameters)),
-			types: make([]uint8, len(stmt.Parameters)),
+			names: make([]string, len(params)),
+			types: make([]uint8, len(params)),
 		},
 	}
 
-	for i := 0; i < len(stmt.Parameters); i++ {
-		switch exp := stmt.Parameters[i].(type) {
+	for i := 0; i < len(params); i++ {
+		switch exp := params[i].(type) {
 		case *ast.Identifier:
 			scope.localTable.setLCL(exp.Value, scope.localTable.depth)
 
@@ -242,6 +278,14 @@ func (g *Generator) compileDefStmt(is *InstructionSet, stmt *ast.DefStatement, s
 		}
 	}
 
+	// Stores the given block as a Block object, or nil if there's no block
+	if hasBlockParam {
+		ident := blockParam.Right.(*ast.Identifier)
+		index, depth := scope.localTable.setLCL(ident.Value, scope.localTable.depth)
+		newIS.define(GetBlock, blockParam.Line(), true)
+		newIS.define(SetLocal, blockParam.Line(), depth, index, 1)
+	}
+
 	if len(stmt.BlockStatement.Statements) == 0 {
 		newIS.define(PutNull, stmt.Line())
 	} else {
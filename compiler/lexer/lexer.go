@@ -13,6 +13,8 @@ type Lexer struct {
 	ch           rune
 	line         int
 	FSM          *fsm.FSM
+	// lastToken is the previously returned token, which tells whether a '/' starts a regexp literal or is a division
+	lastToken token.Token
 }
 
 // New initializes a new lexer with input string
@@ -39,6 +41,12 @@ func New(input string) *Lexer {
 
 // NextToken makes lexer tokenize next character(s)
 func (l *Lexer) NextToken() token.Token {
+	tok := l.nextToken()
+	l.lastToken = tok
+	return tok
+}
+
+func (l *Lexer) nextToken() token.Token {
 
 	var tok token.Token
 	l.resetNosymbol()
@@ -67,6 +75,12 @@ func (l *Lexer) NextToken() token.Token {
 			l.readChar()
 			return tok
 		}
+		if l.peekChar() == '>' {
+			tok = token.CreateOperator("->", l.line)
+			l.readChar()
+			l.readChar()
+			return tok
+		}
 		tok = token.CreateOperator("-", l.line)
 	case '!':
 		if l.peekChar() == '=' {
@@ -76,11 +90,26 @@ func (l *Lexer) NextToken() token.Token {
 			tok = token.CreateOperator("!", l.line)
 		}
 	case '/':
-		tok = token.CreateOperator("/", l.line)
+		if l.isRegexpStart() {
+			tok.Line = l.line
+			tok.Literal = l.readRegexp()
+			tok.Type = token.Regexp
+			return tok
+		}
+
+		if l.peekChar() == '=' {
+			l.readChar()
+			tok = token.CreateOperator("/=", l.line)
+		} else {
+			tok = token.CreateOperator("/", l.line)
+		}
 	case '*':
 		if l.peekChar() == '*' {
 			l.readChar()
 			tok = token.CreateOperator("**", l.line)
+		} else if l.peekChar() == '=' {
+			l.readChar()
+			tok = token.CreateOperator("*=", l.line)
 		} else {
 			tok = token.CreateOperator("*", l.line)
 		}
@@ -93,6 +122,9 @@ func (l *Lexer) NextToken() token.Token {
 			} else {
 				tok = token.CreateOperator("<=", l.line)
 			}
+		} else if l.peekChar() == '<' {
+			l.readChar()
+			tok = token.CreateOperator("<<", l.line)
 		} else {
 			tok = token.CreateOperator("<", l.line)
 		}
@@ -115,8 +147,13 @@ func (l *Lexer) NextToken() token.Token {
 		tok = token.CreateOperator("+", l.line)
 	case '.':
 		if l.peekChar() == '.' {
-			tok = token.CreateOperator("..", l.line)
 			l.readChar()
+			if l.peekChar() == '.' {
+				tok = token.CreateOperator("...", l.line)
+				l.readChar()
+			} else {
+				tok = token.CreateOperator("..", l.line)
+			}
 			l.readChar()
 			return tok
 		}
@@ -135,7 +172,7 @@ func (l *Lexer) NextToken() token.Token {
 
 			} else if isLetter(l.peekChar()) {
 				tok.Literal = string(l.readSymbol())
-				tok.Type = token.String
+				tok.Type = token.Symbol
 				tok.Line = l.line
 				return tok
 
@@ -158,10 +195,24 @@ func (l *Lexer) NextToken() token.Token {
 	case '&':
 		if l.peekChar() == '&' {
 			l.readChar()
-			tok = token.CreateOperator("&&", l.line)
+			if l.peekChar() == '=' {
+				l.readChar()
+				tok = token.CreateOperator("&&=", l.line)
+			} else {
+				tok = token.CreateOperator("&&", l.line)
+			}
+		} else {
+			tok = token.CreateOperator("&", l.line)
 		}
 	case '%':
-		tok = token.CreateOperator("%", l.line)
+		if l.peekChar() == '=' {
+			l.readChar()
+			tok = token.CreateOperator("%=", l.line)
+		} else {
+			tok = token.CreateOperator("%", l.line)
+		}
+	case '?':
+		tok = token.CreateOperator("?", l.line)
 	case '#':
 		tok.Literal = string(l.absorbComment())
 		tok.Type = token.Comment
@@ -257,7 +308,8 @@ func (l *Lexer) readIdentifier() []rune {
 		l.readChar()
 	}
 
-	if l.ch == '?' {
+	// `!=` after an identifier is an operator, not a part of the method name
+	if l.ch == '?' || (l.ch == '!' && l.peekChar() != '=') {
 		l.readChar()
 	}
 
@@ -311,6 +363,81 @@ func (l *Lexer) readString(ch rune) string {
 	return result
 }
 
+// isRegexpStart reports whether the current '/' starts a regexp literal, which is closed on the same line.
+// It's a division or a method name instead if it follows a value like `a / b` and `(a + b) / 2`, or follows `def`.
+// After an identifier followed by a space, like `foo /a/`, it's a regexp argument as in Ruby; the lexer can't tell a local
+// variable from a method, so `x /2/ y` is lexed the same way.
+func (l *Lexer) isRegexpStart() bool {
+	if l.FSM.Is("method") {
+		return false
+	}
+
+	// A '/' at the beginning of a line starts a new statement
+	if l.lastToken.Line == l.line {
+		switch l.lastToken.Type {
+		case token.Ident:
+			// Like Ruby, `foo /a/` passes a regexp to the method `foo`, while `foo / a` and `foo/a` are divisions
+			if !l.isSpaceBefore() || l.peekChar() == ' ' || l.peekChar() == '=' {
+				return false
+			}
+		case token.Constant, token.InstanceVariable, token.Int, token.Float, token.String, token.Symbol, token.Regexp,
+			token.RParen, token.RBracket, token.RBrace, token.True, token.False, token.Null, token.Self:
+			return false
+		}
+	}
+
+	for i := l.readPosition; i < len(l.input) && l.input[i] != '\n'; i++ {
+		switch l.input[i] {
+		case '\\':
+			i++
+		case '/':
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSpaceBefore reports whether the current char follows a space or a tab
+func (l *Lexer) isSpaceBefore() bool {
+	return l.position > 0 && (l.input[l.position-1] == ' ' || l.input[l.position-1] == '\t')
+}
+
+// readRegexp reads a regexp literal like `/a(b)c/i` and returns its pattern.
+// Trailing `i`, `m` and `x` options are turned into a leading inline flag group like `(?i)`,
+// where `m` makes `.` match newlines as in Ruby.
+func (l *Lexer) readRegexp() string {
+	var pattern []rune
+
+	for l.readChar(); l.ch != '/'; l.readChar() {
+		if l.ch == '\\' {
+			if l.peekChar() != '/' {
+				pattern = append(pattern, l.ch)
+			}
+			l.readChar()
+		}
+
+		pattern = append(pattern, l.ch)
+	}
+
+	var flags []rune
+	for {
+		switch l.peekChar() {
+		case 'i', 'x':
+			flags = append(flags, l.peekChar())
+		case 'm':
+			flags = append(flags, 's')
+		default:
+			l.readChar() // move to the char after the literal
+			if len(flags) > 0 {
+				return "(?" + string(flags) + ")" + string(pattern)
+			}
+			return string(pattern)
+		}
+		l.readChar()
+	}
+}
+
 func (l *Lexer) readSymbol() []rune {
 	l.readChar()
 
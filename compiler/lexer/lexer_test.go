@@ -125,6 +125,20 @@ func TestNextToken(t *testing.T) {
 	'\"string\"'
 	"\'string\'"
 	'\'string\''
+
+	a.reverse!
+	foo.empty?
+	b!=c
+	(1...5)
+	a.nil? ? b : c
+	d &&= false
+	e *= 2; f /= 3; g %= 4
+	foo(&b)
+	-> (x) { x }
+	a << 1 << b
+	/a\/b/i =~ c / 2
+	x = (1) / /[0-9]+\./m
+	foo /a/; y/ 3 / z; x /2
 	`
 
 	tests := []struct {
@@ -365,7 +379,7 @@ func TestNextToken(t *testing.T) {
 		{token.String, "", 85},
 
 		{token.Next, "next", 87},
-		{token.String, "apple", 88},
+		{token.Symbol, "apple", 88},
 
 		{token.LBrace, "{", 89},
 		{token.Ident, "test", 89},
@@ -376,7 +390,7 @@ func TestNextToken(t *testing.T) {
 		{token.LBrace, "{", 90},
 		{token.Ident, "test", 90},
 		{token.Colon, ":", 90},
-		{token.String, "abc", 90},
+		{token.Symbol, "abc", 90},
 		{token.RBrace, "}", 90},
 
 		{token.LBrace, "{", 91},
@@ -439,7 +453,88 @@ func TestNextToken(t *testing.T) {
 		{token.String, "'string'", 117},
 		{token.String, "'string'", 118},
 
-		{token.EOF, "", 119},
+		{token.Ident, "a", 120},
+		{token.Dot, ".", 120},
+		{token.Ident, "reverse!", 120},
+		{token.Ident, "foo", 121},
+		{token.Dot, ".", 121},
+		{token.Ident, "empty?", 121},
+		{token.Ident, "b", 122},
+		{token.NotEq, "!=", 122},
+		{token.Ident, "c", 122},
+		{token.LParen, "(", 123},
+		{token.Int, "1", 123},
+		{token.ExclusiveRange, "...", 123},
+		{token.Int, "5", 123},
+		{token.RParen, ")", 123},
+		{token.Ident, "a", 124},
+		{token.Dot, ".", 124},
+		{token.Ident, "nil?", 124},
+		{token.Question, "?", 124},
+		{token.Ident, "b", 124},
+		{token.Colon, ":", 124},
+		{token.Ident, "c", 124},
+		{token.Ident, "d", 125},
+		{token.AndEq, "&&=", 125},
+		{token.False, "false", 125},
+		{token.Ident, "e", 126},
+		{token.AsteriskEq, "*=", 126},
+		{token.Int, "2", 126},
+		{token.Semicolon, ";", 126},
+		{token.Ident, "f", 126},
+		{token.SlashEq, "/=", 126},
+		{token.Int, "3", 126},
+		{token.Semicolon, ";", 126},
+		{token.Ident, "g", 126},
+		{token.ModuloEq, "%=", 126},
+		{token.Int, "4", 126},
+		{token.Ident, "foo", 127},
+		{token.LParen, "(", 127},
+		{token.Ampersand, "&", 127},
+		{token.Ident, "b", 127},
+		{token.RParen, ")", 127},
+		{token.Arrow, "->", 128},
+		{token.LParen, "(", 128},
+		{token.Ident, "x", 128},
+		{token.RParen, ")", 128},
+		{token.LBrace, "{", 128},
+		{token.Ident, "x", 128},
+		{token.RBrace, "}", 128},
+
+		{token.Ident, "a", 129},
+		{token.LShift, "<<", 129},
+		{token.Int, "1", 129},
+		{token.LShift, "<<", 129},
+		{token.Ident, "b", 129},
+
+		{token.Regexp, "(?i)a/b", 130},
+		{token.Match, "=~", 130},
+		{token.Ident, "c", 130},
+		{token.Slash, "/", 130},
+		{token.Int, "2", 130},
+
+		{token.Ident, "x", 131},
+		{token.Assign, "=", 131},
+		{token.LParen, "(", 131},
+		{token.Int, "1", 131},
+		{token.RParen, ")", 131},
+		{token.Slash, "/", 131},
+		{token.Regexp, "(?s)[0-9]+\\.", 131},
+
+		{token.Ident, "foo", 132},
+		{token.Regexp, "a", 132},
+		{token.Semicolon, ";", 132},
+		{token.Ident, "y", 132},
+		{token.Slash, "/", 132},
+		{token.Int, "3", 132},
+		{token.Slash, "/", 132},
+		{token.Ident, "z", 132},
+		{token.Semicolon, ";", 132},
+		{token.Ident, "x", 132},
+		{token.Slash, "/", 132},
+		{token.Int, "2", 132},
+
+		{token.EOF, "", 133},
 	}
 	l := New(input)
 
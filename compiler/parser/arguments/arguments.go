@@ -9,6 +9,7 @@ const (
 	SplatArg
 	RequiredKeywordArg
 	OptionalKeywordArg
+	BlockArg
 )
 
 // Types is a table maps argument types enum to the their real name
@@ -18,12 +19,15 @@ var Types = map[int]string{
 	RequiredKeywordArg: "Keyword argument",
 	OptionalKeywordArg: "Optioned keyword argument",
 	SplatArg:           "Splat argument",
+	BlockArg:           "Block argument",
 }
 
 // Tokens marks token types that can be used as method call arguments
 var Tokens = map[token.Type]bool{
 	token.Int:              true,
 	token.String:           true,
+	token.Symbol:           true,
+	token.Regexp:           true,
 	token.True:             true,
 	token.False:            true,
 	token.Null:             true,
@@ -45,6 +45,14 @@ func (p *Parser) parseFloatLiteral(integerPart ast.Expression) ast.Expression {
 func (p *Parser) parseStringLiteral() ast.Expression {
 	lit := &ast.StringLiteral{BaseNode: &ast.BaseNode{Token: p.curToken}}
 	lit.Value = p.curToken.Literal
+	lit.IsSymbol = p.curToken.Type == token.Symbol
+
+	return lit
+}
+
+func (p *Parser) parseRegexpLiteral() ast.Expression {
+	lit := &ast.RegexpLiteral{BaseNode: &ast.BaseNode{Token: p.curToken}}
+	lit.Value = p.curToken.Literal
 
 	return lit
 }
@@ -68,35 +76,29 @@ func (p *Parser) parseNilExpression() ast.Expression {
 }
 
 func (p *Parser) parseHashExpression() ast.Expression {
-	hash := &ast.HashExpression{BaseNode: &ast.BaseNode{Token: p.curToken}}
-	hash.Data = p.parseHashPairs()
+	hash := &ast.HashExpression{BaseNode: &ast.BaseNode{Token: p.curToken}, Data: map[string]ast.Expression{}}
+	p.parseHashPairs(hash)
 	return hash
 }
 
-func (p *Parser) parseHashPairs() map[string]ast.Expression {
-	pairs := map[string]ast.Expression{}
-
+func (p *Parser) parseHashPairs(hash *ast.HashExpression) {
 	if p.peekTokenIs(token.RBrace) {
 		p.nextToken() // '}'
-		return pairs
+		return
 	}
 
-	p.parseHashPair(pairs)
+	p.parseHashPair(hash)
 
 	for p.peekTokenIs(token.Comma) {
 		p.nextToken()
 
-		p.parseHashPair(pairs)
-	}
-
-	if !p.expectPeek(token.RBrace) {
-		return nil
+		p.parseHashPair(hash)
 	}
 
-	return pairs
+	p.expectPeek(token.RBrace)
 }
 
-func (p *Parser) parseHashPair(pairs map[string]ast.Expression) {
+func (p *Parser) parseHashPair(hash *ast.HashExpression) {
 	var key string
 	var value ast.Expression
 
@@ -116,7 +118,12 @@ func (p *Parser) parseHashPair(pairs map[string]ast.Expression) {
 
 	p.nextToken()
 	value = p.parseExpression(precedence.Normal)
-	pairs[key] = value
+
+	// A duplicated key keeps its first position, like Ruby does
+	if _, ok := hash.Data[key]; !ok {
+		hash.Keys = append(hash.Keys, key)
+	}
+	hash.Data[key] = value
 }
 
 func (p *Parser) parseArrayExpression() ast.Expression {
@@ -151,8 +158,9 @@ func (p *Parser) parseArrayElements() []ast.Expression {
 
 func (p *Parser) parseRangeExpression(left ast.Expression) ast.Expression {
 	exp := &ast.RangeExpression{
-		BaseNode: &ast.BaseNode{Token: p.curToken},
-		Start:    left,
+		BaseNode:  &ast.BaseNode{Token: p.curToken},
+		Start:     left,
+		Exclusive: p.curTokenIs(token.ExclusiveRange),
 	}
 
 	precedence := p.curPrecedence()
@@ -18,6 +18,12 @@ type (
 )
 
 func (p *Parser) parseAssignExpression(v ast.Expression) ast.Expression {
+	// `a && b = 10` only assigns to `b`, like `a && (b = 10)`
+	if infix, ok := v.(*ast.InfixExpression); ok && (infix.Operator == token.And || infix.Operator == token.Or) {
+		infix.Right = p.parseAssignExpression(infix.Right)
+		return infix
+	}
+
 	var value ast.Expression
 	var tok token.Token
 	exp := &ast.AssignExpression{BaseNode: &ast.BaseNode{}}
@@ -42,9 +48,39 @@ func (p *Parser) parseAssignExpression(v ast.Expression) ast.Expression {
 			a[i] = a[i] + b
 
 			CallExp = CallExp + Expression
+
+			both calls share `a` and `i`, so the compiler only evaluates them once
 		*/
 
 		if v.Method == "[]" {
+			/*
+				for cases like: `a[i] ||= b`
+				which needs to be expand to
+
+				a[i] || a[i] = b
+
+				so the element is only written when needed, and the compiler still evaluates `a` and `i` once
+			*/
+			if p.curTokenIs(token.OrEq) || p.curTokenIs(token.AndEq) {
+				operator := token.CreateOperator("||", p.curToken.Line)
+				if p.curTokenIs(token.AndEq) {
+					operator = token.CreateOperator("&&", p.curToken.Line)
+				}
+
+				p.nextToken()
+				callExp := &ast.CallExpression{
+					BaseNode:  &ast.BaseNode{},
+					Method:    "[]=",
+					Arguments: []ast.Expression{v.Arguments[0], p.parseExpression(precedence.Lowest)},
+					Receiver:  v.Receiver,
+				}
+
+				event, _ := events.EventTable[oldState]
+				p.fsm.Event(event)
+
+				return newInfixExpression(v, operator, callExp)
+			}
+
 			value = p.expandAssignmentValue(v)
 
 			callExp := &ast.CallExpression{
@@ -134,10 +170,13 @@ func (p *Parser) parseExpression(precedence int) ast.Expression {
 		a = foo 10
 		```
 	*/
+	var leftExp ast.Expression
+
 	if p.curTokenIs(token.Ident) && (p.fsm.Is(states.Normal) || p.fsm.Is(states.ParsingAssignment)) {
-		if p.peekTokenIs(token.Do) {
+		// The call can still be chained like `lambda { 1 }.call`, so we don't return here
+		if p.peekBlockStart() {
 			method := p.parseIdentifier()
-			return p.parseCallExpressionWithoutReceiver(method)
+			leftExp = p.parseCallExpressionWithoutReceiver(method)
 		}
 
 		/*
@@ -158,14 +197,16 @@ func (p *Parser) parseExpression(precedence int) ast.Expression {
 
 			will also enter this condition first, but we'll check if those two token is at same line in the parsing function
 		*/
-		if arguments.Tokens[p.peekToken.Type] && p.peekTokenAtSameLine() {
+		if leftExp == nil && arguments.Tokens[p.peekToken.Type] && p.peekTokenAtSameLine() {
 			method := p.parseIdentifier()
 			p.nextToken()
 			return p.parseCallExpressionWithoutReceiver(method)
 		}
 	}
 
-	leftExp := parseFn()
+	if leftExp == nil {
+		leftExp = parseFn()
+	}
 
 	/*
 		Precedence example:
@@ -272,8 +313,12 @@ func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
 		return nil
 	}
 
-	if operator.Literal == "||" || operator.Literal == "&&" {
-		preced = precedence.Normal
+	// `and` and `or` are the keyword forms of `&&` and `||`, which only differ in precedence
+	switch operator.Type {
+	case token.AndKeyword:
+		operator = token.CreateOperator(token.And, operator.Line)
+	case token.OrKeyword:
+		operator = token.CreateOperator(token.Or, operator.Line)
 	}
 
 	p.nextToken()
@@ -367,9 +412,11 @@ func (p *Parser) parsePrefixExpression() ast.Expression {
 	prevToken := p.curToken
 	p.nextToken()
 
-	if prevToken.Type == token.Bang {
+	switch prevToken.Type {
+	case token.Bang, token.Ampersand:
+		// `!a.b` and `&a.b` apply to the whole `a.b`
 		pe.Right = p.parseExpression(precedence.BangPrefix)
-	} else {
+	default:
 		pe.Right = p.parseExpression(precedence.MinusPrefix)
 	}
 
@@ -404,7 +451,7 @@ func (p *Parser) expandAssignmentValue(value ast.Expression) ast.Expression {
 		precedence := p.curPrecedence()
 		p.nextToken()
 		return p.parseExpression(precedence)
-	case token.MinusEq, token.PlusEq, token.OrEq:
+	case token.MinusEq, token.PlusEq, token.AsteriskEq, token.SlashEq, token.ModuloEq, token.OrEq, token.AndEq:
 		// Syntax Surgar: Assignment with operator case
 		var infixOperator token.Token
 
@@ -413,8 +460,16 @@ func (p *Parser) expandAssignmentValue(value ast.Expression) ast.Expression {
 			infixOperator = token.CreateOperator("+", p.curToken.Line)
 		case token.MinusEq:
 			infixOperator = token.CreateOperator("-", p.curToken.Line)
+		case token.AsteriskEq:
+			infixOperator = token.CreateOperator("*", p.curToken.Line)
+		case token.SlashEq:
+			infixOperator = token.CreateOperator("/", p.curToken.Line)
+		case token.ModuloEq:
+			infixOperator = token.CreateOperator("%", p.curToken.Line)
 		case token.OrEq:
 			infixOperator = token.CreateOperator("||", p.curToken.Line)
+		case token.AndEq:
+			infixOperator = token.CreateOperator("&&", p.curToken.Line)
 		}
 
 		p.nextToken()
@@ -296,6 +296,109 @@ func TestCallExpressionWithBlock(t *testing.T) {
 	exp.IsCallExpression(t).ShouldHaveMethodName("puts")
 }
 
+func TestCallExpressionWithBraceBlock(t *testing.T) {
+	input := `
+	[1, 2, 3, 4].map { |i| i * 2 }
+	foo { bar }
+	`
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	callExpression := program.FirstStmt().IsExpression(t).IsCallExpression(t)
+	callExpression.TestableReceiver().IsArrayExpression(t)
+	callExpression.ShouldHaveMethodName("map")
+	callExpression.BlockArguments[0].IsIdentifier(t).ShouldHaveName("i")
+
+	block := callExpression.Block
+	exp := block.Statements[0].(ast.TestableStatement).IsExpression(t)
+	exp.IsInfixExpression(t).ShouldHaveOperator("*")
+
+	callExpression = program.NthStmt(2).IsExpression(t).IsCallExpression(t)
+	callExpression.ShouldHaveMethodName("foo")
+
+	block = callExpression.Block
+	exp = block.Statements[0].(ast.TestableStatement).IsExpression(t)
+	exp.IsIdentifier(t).ShouldHaveName("bar")
+}
+
+func TestLambdaExpression(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedParams []string
+	}{
+		{`-> { 1 }`, []string{}},
+		{`-> () { 1 }`, []string{}},
+		{`-> (x) { 1 }`, []string{"x"}},
+		{`->(x, y) { 1 }`, []string{"x", "y"}},
+		{`
+		-> (x, y) do
+		  1
+		end`, []string{"x", "y"}},
+		{`lambda { |x, y| 1 }`, []string{"x", "y"}},
+	}
+
+	for i, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		program, err := p.ParseProgram()
+
+		if err != nil {
+			t.Fatalf("At case %d: %s", i, err.Message)
+		}
+
+		callExpression := program.FirstStmt().IsExpression(t).IsCallExpression(t)
+		callExpression.TestableReceiver().IsSelfExpression(t)
+		callExpression.ShouldHaveMethodName("lambda")
+		callExpression.ShouldHaveNumbersOfArguments(0)
+
+		if len(callExpression.BlockArguments) != len(tt.expectedParams) {
+			t.Fatalf("At case %d: expect %d params, got: %d", i, len(tt.expectedParams), len(callExpression.BlockArguments))
+		}
+
+		for j, param := range tt.expectedParams {
+			callExpression.BlockArguments[j].IsIdentifier(t).ShouldHaveName(param)
+		}
+
+		exp := callExpression.Block.Statements[0].(ast.TestableStatement).IsExpression(t)
+		exp.IsIntegerLiteral(t).ShouldEqualTo(1)
+	}
+}
+
+func TestLambdaExpressionFail(t *testing.T) {
+	input := `-> (x) 1`
+
+	l := lexer.New(input)
+	p := New(l)
+	_, err := p.ParseProgram()
+
+	if err == nil || err.Message != "expected next token to be {, got INT(1) instead. Line: 0" {
+		t.Fatal("Expect lambda without block to fail")
+	}
+}
+
+func TestCallShorthand(t *testing.T) {
+	input := `foo.(1, 2)`
+
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	callExpression := program.FirstStmt().IsExpression(t).IsCallExpression(t)
+	callExpression.TestableReceiver().IsIdentifier(t).ShouldHaveName("foo")
+	callExpression.ShouldHaveMethodName("call")
+	callExpression.NthArgument(1).IsIntegerLiteral(t).ShouldEqualTo(1)
+	callExpression.NthArgument(2).IsIntegerLiteral(t).ShouldEqualTo(2)
+}
+
 func TestCaseExpression(t *testing.T) {
 	input := `
 	case 2
@@ -540,6 +643,143 @@ func TestIfExpression(t *testing.T) {
 	alternativeExp.TestableRightExpression().IsIntegerLiteral(t).ShouldEqualTo(4)
 }
 
+func TestUnlessExpression(t *testing.T) {
+	input := `
+	unless x < y
+	  @x + 5
+	else
+	  y + 4
+	end
+	`
+
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	exp := program.FirstStmt().IsExpression(t).IsIfExpression(t)
+	exp.ShouldHaveNumberOfConditionals(1)
+
+	if !exp.Unless {
+		t.Fatal("Expect if expression to be unless")
+	}
+
+	c := exp.TestableConditionals()[0].IsConditionalExpression(t)
+	condition := c.TestableCondition().IsInfixExpression(t)
+	condition.ShouldHaveOperator("<")
+	condition.TestableLeftExpression().IsIdentifier(t).ShouldHaveName("x")
+	condition.TestableRightExpression().IsIdentifier(t).ShouldHaveName("y")
+	consequence := c.TestableConsequence().NthStmt(1).IsExpression(t).IsInfixExpression(t)
+	consequence.ShouldHaveOperator("+")
+	consequence.TestableLeftExpression().IsInstanceVariable(t).ShouldHaveName("@x")
+
+	alternative := exp.TestableAlternative().NthStmt(1).IsExpression(t).IsInfixExpression(t)
+	alternative.ShouldHaveOperator("+")
+	alternative.TestableLeftExpression().IsIdentifier(t).ShouldHaveName("y")
+}
+
+func TestUnlessModifier(t *testing.T) {
+	input := `foo(1) unless x.empty?`
+
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	exp := program.FirstStmt().IsExpression(t).IsIfExpression(t)
+	exp.ShouldHaveNumberOfConditionals(1)
+
+	if !exp.Unless {
+		t.Fatal("Expect if expression to be unless")
+	}
+
+	c := exp.TestableConditionals()[0].IsConditionalExpression(t)
+	c.TestableCondition().IsCallExpression(t).ShouldHaveMethodName("empty?")
+	c.TestableConsequence().NthStmt(1).IsExpression(t).IsCallExpression(t).ShouldHaveMethodName("foo")
+}
+
+func TestUnlessModifierWithReturn(t *testing.T) {
+	input := `return 1 unless x`
+
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	exp := program.FirstStmt().IsExpression(t).IsIfExpression(t)
+	exp.ShouldHaveNumberOfConditionals(1)
+
+	c := exp.TestableConditionals()[0].IsConditionalExpression(t)
+	c.TestableCondition().IsIdentifier(t).ShouldHaveName("x")
+	c.TestableConsequence().NthStmt(1).IsReturnStmt(t).ShouldHaveValue(1)
+}
+
+func TestUnlessExpressionWithElsifFail(t *testing.T) {
+	input := `
+	unless x
+	  1
+	elsif y
+	  2
+	end
+	`
+
+	l := lexer.New(input)
+	p := New(l)
+	_, err := p.ParseProgram()
+
+	if err == nil || err.Message != "syntax error, unexpected elsif in unless expression. Line: 3" {
+		t.Fatalf("Expect unless expression with elsif to fail. got: %v", err)
+	}
+}
+
+func TestTernaryExpression(t *testing.T) {
+	input := `x < y ? @x + 5 : y.foo(1)`
+
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	exp := program.FirstStmt().IsExpression(t).IsIfExpression(t)
+	exp.ShouldHaveNumberOfConditionals(1)
+
+	c := exp.TestableConditionals()[0].IsConditionalExpression(t)
+	condition := c.TestableCondition().IsInfixExpression(t)
+	condition.ShouldHaveOperator("<")
+	condition.TestableLeftExpression().IsIdentifier(t).ShouldHaveName("x")
+	condition.TestableRightExpression().IsIdentifier(t).ShouldHaveName("y")
+	consequence := c.TestableConsequence().NthStmt(1).IsExpression(t).IsInfixExpression(t)
+	consequence.ShouldHaveOperator("+")
+	consequence.TestableLeftExpression().IsInstanceVariable(t).ShouldHaveName("@x")
+	consequence.TestableRightExpression().IsIntegerLiteral(t).ShouldEqualTo(5)
+
+	alternative := exp.TestableAlternative().NthStmt(1).IsExpression(t).IsCallExpression(t)
+	alternative.ShouldHaveMethodName("foo")
+	alternative.NthArgument(1).IsIntegerLiteral(t).ShouldEqualTo(1)
+}
+
+func TestTernaryExpressionFail(t *testing.T) {
+	l := lexer.New(`a ? b c`)
+	p := New(l)
+	_, err := p.ParseProgram()
+
+	if err == nil {
+		t.Fatal("Expect ternary expression without colon to fail")
+	}
+}
+
 func TestInfixExpression(t *testing.T) {
 	infixTests := []struct {
 		input      string
@@ -549,6 +789,9 @@ func TestInfixExpression(t *testing.T) {
 	}{
 		{"4 + 1;", 4, "+", 1},
 		{"3 - 2;", 3, "-", 2},
+		{"3 <=> 2;", 3, "<=>", 2},
+		{"3 | 2;", 3, "|", 2},
+		{"3 & 2;", 3, "&", 2},
 	}
 
 	for _, tt := range infixTests {
@@ -693,6 +936,52 @@ func TestStringLiteralExpression(t *testing.T) {
 	}
 }
 
+func TestRegexpLiteralExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `/[a-z]+/;`, expected: "[a-z]+"},
+		{input: `/^a\/b$/;`, expected: "^a/b$"},
+		{input: `/\d\s/;`, expected: `\d\s`},
+		{input: `/abc/mi;`, expected: "(?si)abc"},
+		{input: `//;`, expected: ""},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		program, err := p.ParseProgram()
+
+		if err != nil {
+			t.Fatal(err.Message)
+		}
+
+		literal := program.FirstStmt().IsExpression(t).IsRegexpLiteral(t)
+		literal.ShouldEqualTo(tt.expected)
+	}
+}
+
+func TestRegexpLiteralInInfixExpression(t *testing.T) {
+	input := `"pizza" =~ /zz/ / 2`
+
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	match := program.FirstStmt().IsExpression(t).IsInfixExpression(t)
+	match.ShouldHaveOperator("=~")
+	match.TestableLeftExpression().IsStringLiteral(t).ShouldEqualTo("pizza")
+	exp := match.TestableRightExpression().IsInfixExpression(t)
+	exp.ShouldHaveOperator("/")
+	exp.TestableLeftExpression().IsRegexpLiteral(t).ShouldEqualTo("zz")
+	exp.TestableRightExpression().IsIntegerLiteral(t).ShouldEqualTo(2)
+}
+
 func TestArithmeticExpressionFail(t *testing.T) {
 	tests := []struct {
 		input string
@@ -1,7 +1,10 @@
 package parser
 
 import (
+	"fmt"
+
 	"github.com/goby-lang/goby/compiler/ast"
+	"github.com/goby-lang/goby/compiler/parser/errors"
 	"github.com/goby-lang/goby/compiler/parser/precedence"
 	"github.com/goby-lang/goby/compiler/token"
 )
@@ -102,6 +105,75 @@ func (p *Parser) parseIfExpression() ast.Expression {
 	return ie
 }
 
+func (p *Parser) parseUnlessExpression() ast.Expression {
+	ie := &ast.IfExpression{BaseNode: &ast.BaseNode{Token: p.curToken}, Unless: true}
+	ie.Conditionals = []*ast.ConditionalExpression{p.parseConditionalExpression()}
+
+	if p.curTokenIs(token.ElsIf) {
+		msg := fmt.Sprintf("syntax error, unexpected elsif in unless expression. Line: %d", p.curToken.Line)
+		p.error = errors.InitError(msg, errors.SyntaxError)
+		return nil
+	}
+
+	// curToken is now ELSE or END
+	if p.curTokenIs(token.Else) {
+		ie.Alternative = p.parseBlockStatement(token.End)
+		ie.Alternative.KeepLastValue()
+	}
+
+	return ie
+}
+
+// parseUnlessModifier wraps the statement into an unless expression, curToken is the statement's last token.
+// tok is the statement's first token.
+func (p *Parser) parseUnlessModifier(stmt ast.Statement, tok token.Token) *ast.ExpressionStatement {
+	p.nextToken()
+	ie := &ast.IfExpression{BaseNode: &ast.BaseNode{Token: p.curToken}, Unless: true}
+	ce := &ast.ConditionalExpression{BaseNode: &ast.BaseNode{Token: p.curToken}}
+
+	p.nextToken()
+	ce.Condition = p.parseExpression(precedence.Normal)
+	ce.Consequence = &ast.BlockStatement{BaseNode: &ast.BaseNode{Token: tok}, Statements: []ast.Statement{stmt}}
+	ie.Conditionals = []*ast.ConditionalExpression{ce}
+
+	// The unless expression takes over the statement's role, and the statement becomes the value of it.
+	// Jump statements like `return` have no value, so the unless expression is always a statement then.
+	if exp, ok := stmt.(*ast.ExpressionStatement); !ok || exp.Expression.IsStmt() {
+		ie.MarkAsStmt()
+	}
+	ce.Consequence.KeepLastValue()
+
+	return &ast.ExpressionStatement{BaseNode: &ast.BaseNode{Token: tok}, Expression: ie}
+}
+
+// parseTernaryExpression parses `condition ? consequence : alternative` into an if expression.
+func (p *Parser) parseTernaryExpression(condition ast.Expression) ast.Expression {
+	ie := &ast.IfExpression{BaseNode: &ast.BaseNode{Token: p.curToken}}
+	ce := &ast.ConditionalExpression{BaseNode: &ast.BaseNode{Token: p.curToken}, Condition: condition}
+
+	// Parsing with a lower precedence than the ternary's allows nesting, and makes it right-associative
+	p.nextToken()
+	ce.Consequence = p.newSingleExpressionBlock(p.parseExpression(precedence.Assign))
+
+	if !p.expectPeek(token.Colon) {
+		return nil
+	}
+
+	alternativeTok := p.curToken
+	p.nextToken()
+
+	ie.Alternative = p.newSingleExpressionBlock(p.parseExpression(precedence.Assign))
+	ie.Alternative.Token = alternativeTok
+	ie.Conditionals = []*ast.ConditionalExpression{ce}
+
+	return ie
+}
+
+func (p *Parser) newSingleExpressionBlock(exp ast.Expression) *ast.BlockStatement {
+	stmt := &ast.ExpressionStatement{BaseNode: &ast.BaseNode{Token: p.curToken}, Expression: exp}
+	return &ast.BlockStatement{BaseNode: &ast.BaseNode{Token: p.curToken}, Statements: []ast.Statement{stmt}}
+}
+
 // infix expression parsing helpers
 func (p *Parser) parseConditionalExpressions() []*ast.ConditionalExpression {
 	// first conditional expression should start with if
@@ -34,7 +34,7 @@ func (p *Parser) parseCallExpressionWithoutReceiver(receiver ast.Expression) ast
 
 	p.fsm.Event(events.EventTable[oldState])
 
-	if p.peekTokenIs(token.Do) && p.acceptBlock { // foo do
+	if p.peekBlockStart() { // foo do || foo {
 		p.parseBlockArgument(exp)
 	}
 
@@ -47,6 +47,18 @@ func (p *Parser) parseCallExpressionWithReceiver(receiver ast.Expression) ast.Ex
 	oldState := p.fsm.Current()
 	p.fsm.Event(events.ParseFuncCall)
 
+	// `block.(x)` is a shorthand of `block.call(x)`
+	if p.peekTokenIs(token.LParen) {
+		p.nextToken()
+		exp.Token = token.Token{Type: token.Ident, Literal: "call", Line: p.curToken.Line}
+		exp.Receiver = receiver
+		exp.Method = "call"
+		exp.Arguments = p.parseCallArgumentsWithParens()
+
+		p.fsm.Event(events.EventTable[oldState])
+		return exp
+	}
+
 	// check if method name is identifier
 	if !p.expectPeek(token.Ident) {
 		return nil
@@ -84,7 +96,7 @@ func (p *Parser) parseCallExpressionWithReceiver(receiver ast.Expression) ast.Ex
 	p.fsm.Event(events.EventTable[oldState])
 
 	// Parse block
-	if p.peekTokenIs(token.Do) && p.acceptBlock {
+	if p.peekBlockStart() {
 		p.parseBlockArgument(exp)
 	}
 
@@ -124,9 +136,23 @@ func (p *Parser) parseCallArguments() []ast.Expression {
 	return args
 }
 
+// peekBlockStart returns true if the next token starts a `do ... end` or `{ ... }` block of the current method call
+func (p *Parser) peekBlockStart() bool {
+	if !p.acceptBlock {
+		return false
+	}
+
+	return p.peekTokenIs(token.Do) || (p.peekTokenIs(token.LBrace) && p.peekTokenAtSameLine())
+}
+
 func (p *Parser) parseBlockArgument(exp *ast.CallExpression) {
 	p.nextToken()
 
+	var endToken token.Type = token.End
+	if p.curTokenIs(token.LBrace) {
+		endToken = token.RBrace
+	}
+
 	// Parse block arguments
 	if p.peekTokenIs(token.Bar) {
 		var params []*ast.Identifier
@@ -151,6 +177,45 @@ func (p *Parser) parseBlockArgument(exp *ast.CallExpression) {
 		exp.BlockArguments = params
 	}
 
-	exp.Block = p.parseBlockStatement(token.End)
+	exp.Block = p.parseBlockStatement(endToken)
 	exp.Block.KeepLastValue()
 }
+
+// parseLambdaExpression parses `-> (x) { x + 1 }` as `lambda { |x| x + 1 }`
+func (p *Parser) parseLambdaExpression() ast.Expression {
+	selfTok := token.Token{Type: token.Self, Literal: "self", Line: p.curToken.Line}
+	exp := &ast.CallExpression{
+		BaseNode:  &ast.BaseNode{Token: token.Token{Type: token.Ident, Literal: "lambda", Line: p.curToken.Line}},
+		Receiver:  &ast.SelfExpression{BaseNode: &ast.BaseNode{Token: selfTok}},
+		Method:    "lambda",
+		Arguments: []ast.Expression{},
+	}
+
+	if p.peekTokenIs(token.LParen) {
+		p.nextToken()
+
+		for !p.peekTokenIs(token.RParen) {
+			if !p.expectPeek(token.Ident) {
+				return nil
+			}
+
+			param := &ast.Identifier{BaseNode: &ast.BaseNode{Token: p.curToken}, Value: p.curToken.Literal}
+			exp.BlockArguments = append(exp.BlockArguments, param)
+
+			if p.peekTokenIs(token.Comma) {
+				p.nextToken()
+			}
+		}
+
+		p.nextToken()
+	}
+
+	if !p.peekTokenIs(token.LBrace) && !p.peekTokenIs(token.Do) {
+		p.peekError(token.LBrace)
+		return nil
+	}
+
+	p.parseBlockArgument(exp)
+
+	return exp
+}
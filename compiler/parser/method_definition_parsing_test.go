@@ -18,6 +18,10 @@ func TestDefStatement(t *testing.T) {
 	def bar(x = 10, y: ); end
 
 	def baz(z: 100, *s); end
+
+	def qux(a, *s, &b); end
+
+	def quux(a = @b, c = Foo); end
 	`
 
 	l := lexer.New(input)
@@ -55,6 +59,56 @@ func TestDefStatement(t *testing.T) {
 	fourthStmt.ShouldHaveName("baz")
 	fourthStmt.ShouldHaveOptionalKeywordParam("z")
 	fourthStmt.ShouldHaveSplatParam("s")
+
+	fifthStmt := program.NthStmt(5).IsDefStmt(t)
+	fifthStmt.ShouldHaveName("qux")
+	fifthStmt.ShouldHaveNormalParam("a")
+	fifthStmt.ShouldHaveSplatParam("s")
+	fifthStmt.ShouldHaveBlockParam("b")
+
+	sixthStmt := program.NthStmt(6).IsDefStmt(t)
+	sixthStmt.ShouldHaveName("quux")
+	sixthStmt.ShouldHaveOptionalParam("a")
+	sixthStmt.ShouldHaveOptionalParam("c")
+}
+
+func TestDefStatementWithOperatorName(t *testing.T) {
+	input := `
+	def <=>(other); end
+	def ==(other); end
+	def +(other); end
+	def self.<(other); end
+	`
+
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	firstStmt := program.FirstStmt().IsDefStmt(t)
+	firstStmt.ShouldHaveName("<=>")
+	firstStmt.ShouldHaveNormalParam("other")
+
+	program.NthStmt(2).IsDefStmt(t).ShouldHaveName("==")
+	program.NthStmt(3).IsDefStmt(t).ShouldHaveName("+")
+	program.NthStmt(4).IsDefStmt(t).ShouldHaveName("<")
+}
+
+func TestDefStatementWithBlockParamFail(t *testing.T) {
+	input := `
+	def foo(&b, a)
+	end`
+
+	l := lexer.New(input)
+	p := New(l)
+	_, err := p.ParseProgram()
+
+	if err == nil || err.Message != "Block argument should be the last argument. Line: 1" {
+		t.Fatal("Expect block argument to be the last argument")
+	}
 }
 
 func TestDefStatementWithYield(t *testing.T) {
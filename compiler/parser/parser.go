@@ -66,14 +66,18 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerPrefix(token.InstanceVariable, p.parseInstanceVariable)
 	p.registerPrefix(token.Int, p.parseIntegerLiteral)
 	p.registerPrefix(token.String, p.parseStringLiteral)
+	p.registerPrefix(token.Symbol, p.parseStringLiteral)
+	p.registerPrefix(token.Regexp, p.parseRegexpLiteral)
 	p.registerPrefix(token.True, p.parseBooleanLiteral)
 	p.registerPrefix(token.False, p.parseBooleanLiteral)
 	p.registerPrefix(token.Null, p.parseNilExpression)
 	p.registerPrefix(token.Minus, p.parsePrefixExpression)
 	p.registerPrefix(token.Asterisk, p.parsePrefixExpression)
+	p.registerPrefix(token.Ampersand, p.parsePrefixExpression)
 	p.registerPrefix(token.Bang, p.parsePrefixExpression)
 	p.registerPrefix(token.LParen, p.parseGroupedExpression)
 	p.registerPrefix(token.If, p.parseIfExpression)
+	p.registerPrefix(token.Unless, p.parseUnlessExpression)
 	p.registerPrefix(token.Case, p.parseCaseExpression)
 	p.registerPrefix(token.Self, p.parseSelfExpression)
 	p.registerPrefix(token.LBracket, p.parseArrayExpression)
@@ -81,6 +85,7 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerPrefix(token.Semicolon, p.parseSemicolon)
 	p.registerPrefix(token.Yield, p.parseYieldExpression)
 	p.registerPrefix(token.GetBlock, p.parseGetBlockExpression)
+	p.registerPrefix(token.Arrow, p.parseLambdaExpression)
 
 	p.infixParseFns = make(map[token.Type]infixParseFn)
 	p.registerInfix(token.Plus, p.parseInfixExpression)
@@ -88,7 +93,9 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerInfix(token.Minus, p.parseInfixExpression)
 	p.registerInfix(token.MinusEq, p.parseAssignExpression)
 	p.registerInfix(token.Modulo, p.parseInfixExpression)
+	p.registerInfix(token.ModuloEq, p.parseAssignExpression)
 	p.registerInfix(token.Slash, p.parseInfixExpression)
+	p.registerInfix(token.SlashEq, p.parseAssignExpression)
 	p.registerInfix(token.Pow, p.parseInfixExpression)
 	p.registerInfix(token.Eq, p.parseInfixExpression)
 	p.registerInfix(token.NotEq, p.parseInfixExpression)
@@ -98,18 +105,27 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerInfix(token.GT, p.parseInfixExpression)
 	p.registerInfix(token.GTE, p.parseInfixExpression)
 	p.registerInfix(token.COMP, p.parseInfixExpression)
+	p.registerInfix(token.Bar, p.parseInfixExpression)
+	p.registerInfix(token.Ampersand, p.parseInfixExpression)
+	p.registerInfix(token.LShift, p.parseInfixExpression)
 	p.registerInfix(token.And, p.parseInfixExpression)
 	p.registerInfix(token.Or, p.parseInfixExpression)
+	p.registerInfix(token.AndKeyword, p.parseInfixExpression)
+	p.registerInfix(token.OrKeyword, p.parseInfixExpression)
 	p.registerInfix(token.OrEq, p.parseAssignExpression)
+	p.registerInfix(token.AndEq, p.parseAssignExpression)
 	p.registerInfix(token.Comma, p.parseMultiVariables)
 	p.registerInfix(token.ResolutionOperator, p.parseInfixExpression)
 	p.registerInfix(token.Assign, p.parseAssignExpression)
 	p.registerInfix(token.Range, p.parseRangeExpression)
+	p.registerInfix(token.ExclusiveRange, p.parseRangeExpression)
 	p.registerInfix(token.Dot, p.parseCallExpressionWithReceiver)
 	p.registerInfix(token.LParen, p.parseCallExpressionWithoutReceiver)
 	p.registerInfix(token.LBracket, p.parseIndexExpression)
 	p.registerInfix(token.Colon, p.parseArgumentPairExpression)
 	p.registerInfix(token.Asterisk, p.parseInfixExpression)
+	p.registerInfix(token.AsteriskEq, p.parseAssignExpression)
+	p.registerInfix(token.Question, p.parseTernaryExpression)
 
 	return p
 }
@@ -149,11 +165,11 @@ func (p *Parser) ParseProgram() (program *ast.Program, err *errors.Error) {
 	}
 
 	if p.Mode == TestMode {
-		stmt := program.Statements[len(program.Statements)-1]
-		expStmt, ok := stmt.(*ast.ExpressionStatement)
-
-		if ok {
-			expStmt.Expression.MarkAsExp()
+		switch stmt := program.Statements[len(program.Statements)-1].(type) {
+		case *ast.ExpressionStatement:
+			stmt.Expression.MarkAsExp()
+		case *ast.WhileStatement:
+			stmt.MarkAsExp()
 		}
 	}
 
@@ -234,10 +250,31 @@ func (p *Parser) callConstantError(t token.Type) {
 	p.error = errors.InitError(msg, errors.UnexpectedTokenError)
 }
 
+// Operators that can be defined as methods, like `def <=>(other)`
+var operatorMethodNames = map[token.Type]bool{
+	token.Plus:      true,
+	token.Minus:     true,
+	token.Asterisk:  true,
+	token.Pow:       true,
+	token.Slash:     true,
+	token.Modulo:    true,
+	token.Match:     true,
+	token.LT:        true,
+	token.LTE:       true,
+	token.GT:        true,
+	token.GTE:       true,
+	token.COMP:      true,
+	token.Eq:        true,
+	token.NotEq:     true,
+	token.Bar:       true,
+	token.Ampersand: true,
+	token.LShift:    true,
+}
+
 // IsNotDefMethodToken ensures correct naming in Def statement
 func (p *Parser) IsNotDefMethodToken() bool {
 
-	return p.curToken.Type != token.Ident && !(p.peekToken.Type == token.Dot && (p.curToken.Type == token.InstanceVariable || p.curToken.Type == token.Constant || p.curToken.Type == token.Self))
+	return p.curToken.Type != token.Ident && !operatorMethodNames[p.curToken.Type] && !(p.peekToken.Type == token.Dot && (p.curToken.Type == token.InstanceVariable || p.curToken.Type == token.Constant || p.curToken.Type == token.Self))
 }
 
 // Token type InstanceVariable and Constant will trigger IsNotParamsToken()
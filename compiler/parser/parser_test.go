@@ -98,6 +98,26 @@ func TestOperatorPrecedenceParsing(t *testing.T) {
 			"3 + 4 * 5 == 3 * 1 + 4 * 5",
 			"((3 + (4 * 5)) == ((3 * 1) + (4 * 5)))",
 		},
+		{
+			"a + b <=> c * d",
+			"((a + b) <=> (c * d))",
+		},
+		{
+			"a | b & c",
+			"(a | (b & c))",
+		},
+		{
+			"a - b | c == d & e",
+			"(((a - b) | c) == (d & e))",
+		},
+		{
+			"a << b + c << d",
+			"((a << (b + c)) << d)",
+		},
+		{
+			"a << b & c",
+			"((a << b) & c)",
+		},
 		{
 			"true",
 			"true",
@@ -150,6 +170,62 @@ func TestOperatorPrecedenceParsing(t *testing.T) {
 			"n.add(a + b + c * d / f + g)",
 			"n.add((((a + b) + ((c * d) / f)) + g))",
 		},
+		{
+			"a == b || c",
+			"((a == b) || c)",
+		},
+		{
+			"a and b or c",
+			"((a && b) || c)",
+		},
+		{
+			"x = a or b",
+			"(x = a || b)",
+		},
+		{
+			"x = a || b",
+			"x = (a || b)",
+		},
+		{
+			"a or b && c",
+			"(a || (b && c))",
+		},
+		{
+			"a || b && c || d",
+			"((a || (b && c)) || d)",
+		},
+		{
+			"a ? b : c",
+			"(a ? b : c)",
+		},
+		{
+			"a + 1 > b * 2 ? c - 1 : d / 2",
+			"(((a + 1) > (b * 2)) ? (c - 1) : (d / 2))",
+		},
+		{
+			"a == b ? 1 : 2 + 3",
+			"((a == b) ? 1 : (2 + 3))",
+		},
+		{
+			"a ? b : c ? d : e",
+			"(a ? b : (c ? d : e))",
+		},
+		{
+			"a ? b ? c : d : e",
+			"(a ? (b ? c : d) : e)",
+		},
+		{
+			"x = a ? b : c",
+			"x = (a ? b : c)",
+		},
+		{
+			"n.empty? ? n.add(1, 2 * 3) : m.add(a ? b : c)",
+			"(n.empty?() ? n.add(1, (2 * 3)) : m.add((a ? b : c)))",
+		},
+		{
+			"n.add(a ? b : c, d)",
+			"n.add((a ? b : c), d)",
+		},
 	}
 
 	for _, tt := range tests {
@@ -7,11 +7,17 @@ const (
 	_ = iota
 	Lowest
 	Normal
+	LogicalKeyword
 	Assign
-	Logic
+	Ternary
+	Or
+	And
 	Range
 	Equals
 	Compare
+	BitOr
+	BitAnd
+	Shift
 	Sum
 	Product
 	BangPrefix
@@ -30,9 +36,16 @@ var LookupTable = map[token.Type]int{
 	token.GT:                 Compare,
 	token.GTE:                Compare,
 	token.COMP:               Compare,
-	token.And:                Logic,
-	token.Or:                 Logic,
+	token.Bar:                BitOr,
+	token.Ampersand:          BitAnd,
+	token.LShift:             Shift,
+	token.And:                And,
+	token.Or:                 Or,
+	token.AndKeyword:         LogicalKeyword,
+	token.OrKeyword:          LogicalKeyword,
+	token.Question:           Ternary,
 	token.Range:              Range,
+	token.ExclusiveRange:     Range,
 	token.Plus:               Sum,
 	token.Minus:              Sum,
 	token.Modulo:             Sum,
@@ -46,6 +59,10 @@ var LookupTable = map[token.Type]int{
 	token.Assign:             Assign,
 	token.PlusEq:             Assign,
 	token.MinusEq:            Assign,
+	token.AsteriskEq:         Assign,
+	token.SlashEq:            Assign,
+	token.ModuloEq:           Assign,
 	token.OrEq:               Assign,
+	token.AndEq:              Assign,
 	token.Colon:              Assign,
 }
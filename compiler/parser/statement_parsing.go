@@ -14,21 +14,26 @@ import (
 func (p *Parser) parseStatement() ast.Statement {
 	switch p.curToken.Type {
 	case token.Return:
-		return p.parseReturnStatement()
+		tok := p.curToken
+		return p.parseJumpModifier(p.parseReturnStatement(), tok)
 	case token.Def:
 		return p.parseDefMethodStatement()
 	case token.Comment:
 		return nil
-	case token.While:
+	case token.While, token.Until:
 		return p.parseWhileStatement()
 	case token.Class:
 		return p.parseClassStatement()
 	case token.Module:
 		return p.parseModuleStatement()
 	case token.Next:
-		return &ast.NextStatement{BaseNode: &ast.BaseNode{Token: p.curToken}}
+		stmt := &ast.NextStatement{BaseNode: &ast.BaseNode{Token: p.curToken}}
+		stmt.Value = p.parseJumpValue()
+		return p.parseJumpModifier(stmt, stmt.Token)
 	case token.Break:
-		return &ast.BreakStatement{BaseNode: &ast.BaseNode{Token: p.curToken}}
+		stmt := &ast.BreakStatement{BaseNode: &ast.BaseNode{Token: p.curToken}}
+		stmt.Value = p.parseJumpValue()
+		return p.parseJumpModifier(stmt, stmt.Token)
 	default:
 		exp := p.parseExpressionStatement()
 
@@ -42,6 +47,16 @@ func (p *Parser) parseStatement() ast.Statement {
 			}
 		}
 
+		// Modifier forms like `x += 1 while x < 10` or `x += 1 unless x > 10`
+		if exp.Expression != nil && p.peekToken.Line == p.curToken.Line {
+			switch p.peekToken.Type {
+			case token.While, token.Until:
+				return p.parseWhileModifier(exp)
+			case token.Unless:
+				return p.parseUnlessModifier(exp, exp.Token)
+			}
+		}
+
 		return exp
 	}
 }
@@ -74,7 +89,9 @@ func (p *Parser) parseDefMethodStatement() *ast.DefStatement {
 		}
 
 		p.nextToken() // .
-		if !p.expectPeek(token.Ident) {
+		if operatorMethodNames[p.peekToken.Type] {
+			p.nextToken()
+		} else if !p.expectPeek(token.Ident) {
 			return nil
 		}
 	}
@@ -102,7 +119,8 @@ func (p *Parser) parseDefMethodStatement() *ast.DefStatement {
 			params = p.parseParameters()
 		}
 
-		if p.IsNotParamsToken() {
+		// A default value like `a = @b` may end with a token that's invalid as a parameter
+		if p.error != nil {
 			return nil
 		}
 
@@ -173,6 +191,12 @@ func (p *Parser) checkMethodParameters(params []ast.Expression) {
 	checkedParams := []ast.Expression{}
 
 	for _, param := range params {
+		if argState == arguments.BlockArg {
+			msg := fmt.Sprintf("Block argument should be the last argument. Line: %d", p.curToken.Line)
+			p.error = errors.InitError(msg, errors.ArgumentError)
+			break
+		}
+
 		switch exp := param.(type) {
 		case *ast.Identifier:
 			switch argState {
@@ -214,6 +238,11 @@ func (p *Parser) checkMethodParameters(params []ast.Expression) {
 				argState = arguments.OptionalKeywordArg
 			}
 		case *ast.PrefixExpression:
+			if exp.Operator == token.Ampersand {
+				argState = arguments.BlockArg
+				break
+			}
+
 			switch argState {
 			case arguments.SplatArg:
 				msg := fmt.Sprintf("Can't define splat argument more than once. Line: %d", p.curToken.Line)
@@ -279,7 +308,7 @@ func (p *Parser) parseModuleStatement() *ast.ModuleStatement {
 func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
 	stmt := &ast.ReturnStatement{BaseNode: &ast.BaseNode{Token: p.curToken}}
 
-	if !p.peekTokenAtSameLine() {
+	if !p.peekTokenAtSameLine() || p.peekTokenIs(token.Unless) {
 		null := &ast.NilExpression{BaseNode: &ast.BaseNode{Token: p.curToken}}
 		stmt.ReturnValue = null
 		return stmt
@@ -352,8 +381,29 @@ ParseBlockLoop:
 	return bs
 }
 
+// parseJumpValue parses the optional value of `next` or `break`, which should be on the same line.
+// parseJumpModifier wraps a `return`, `next` or `break` statement into an unless expression if it's followed by `unless`
+func (p *Parser) parseJumpModifier(stmt ast.Statement, tok token.Token) ast.Statement {
+	if p.peekTokenIs(token.Unless) && p.peekTokenAtSameLine() {
+		return p.parseUnlessModifier(stmt, tok)
+	}
+
+	return stmt
+}
+
+func (p *Parser) parseJumpValue() ast.Expression {
+	if !p.peekTokenAtSameLine() || p.peekTokenIs(token.End) || p.peekTokenIs(token.Semicolon) || p.peekTokenIs(token.RBrace) || p.peekTokenIs(token.Unless) {
+		return nil
+	}
+
+	p.nextToken()
+
+	return p.parseExpression(precedence.Normal)
+}
+
 func (p *Parser) parseWhileStatement() *ast.WhileStatement {
-	ws := &ast.WhileStatement{BaseNode: &ast.BaseNode{Token: p.curToken}}
+	ws := &ast.WhileStatement{BaseNode: &ast.BaseNode{Token: p.curToken}, Until: p.curTokenIs(token.Until)}
+	ws.MarkAsStmt()
 
 	p.nextToken()
 	// Prevent expression's method call to consume while's block as argument.
@@ -367,9 +417,9 @@ func (p *Parser) parseWhileStatement() *ast.WhileStatement {
 	event, _ := events.EventTable[oldState]
 	p.fsm.Event(event)
 	p.acceptBlock = true
-	p.expectPeek(token.Do)
 
-	if p.curTokenIs(token.Semicolon) {
+	// `do` is optional
+	if p.peekTokenIs(token.Do) {
 		p.nextToken()
 	}
 
@@ -378,6 +428,19 @@ func (p *Parser) parseWhileStatement() *ast.WhileStatement {
 	return ws
 }
 
+// parseWhileModifier wraps the statement into a while (or until) loop's body, curToken is the statement's last token.
+func (p *Parser) parseWhileModifier(stmt *ast.ExpressionStatement) *ast.WhileStatement {
+	p.nextToken()
+	ws := &ast.WhileStatement{BaseNode: &ast.BaseNode{Token: p.curToken}, Until: p.curTokenIs(token.Until)}
+	ws.MarkAsStmt()
+	ws.Body = &ast.BlockStatement{BaseNode: &ast.BaseNode{Token: stmt.Token}, Statements: []ast.Statement{stmt}}
+
+	p.nextToken()
+	ws.Condition = p.parseExpression(precedence.Normal)
+
+	return ws
+}
+
 func paramDuplicated(params []ast.Expression, param ast.Expression) bool {
 	for _, p := range params {
 		if getArgName(param) == getArgName(p) {
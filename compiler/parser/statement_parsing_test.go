@@ -163,7 +163,7 @@ func TestWhileStatement(t *testing.T) {
 	secondCall.NthVariable(1).IsIdentifier(t).ShouldHaveName("i")
 }
 
-func TestWhileStatementWithoutDoKeywordFail(t *testing.T) {
+func TestWhileStatementWithoutDoKeyword(t *testing.T) {
 	input := `
 	while i < a.length
 	  puts(i)
@@ -172,12 +172,87 @@ func TestWhileStatementWithoutDoKeywordFail(t *testing.T) {
 
 	l := lexer.New(input)
 	p := New(l)
-	_, err := p.ParseProgram()
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	whileStatement := program.FirstStmt().IsWhileStmt(t)
 
-	if err.Message != "expected next token to be DO, got IDENT(puts) instead. Line: 2" {
-		t.Fatal("Condition expression should be followed by a do keyword")
+	if whileStatement.Until {
+		t.Fatalf("Expect while statement not to be until")
 	}
 
+	infix := whileStatement.ConditionExpression().IsInfixExpression(t)
+	infix.TestableLeftExpression().IsIdentifier(t).ShouldHaveName("i")
+	infix.ShouldHaveOperator("<")
+
+	block := whileStatement.CodeBlock()
+	block.NthStmt(1).IsExpression(t).IsCallExpression(t).ShouldHaveMethodName("puts")
+	block.NthStmt(2).IsExpression(t).IsAssignExpression(t).NthVariable(1).IsIdentifier(t).ShouldHaveName("i")
+}
+
+func TestUntilStatement(t *testing.T) {
+	input := `
+	until i > 10 do
+	  i += 1
+	end
+	`
+
+	l := lexer.New(input)
+	p := New(l)
+	program, err := p.ParseProgram()
+
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+
+	untilStatement := program.FirstStmt().IsWhileStmt(t)
+
+	if !untilStatement.Until {
+		t.Fatalf("Expect until statement to be until")
+	}
+
+	infix := untilStatement.ConditionExpression().IsInfixExpression(t)
+	infix.TestableLeftExpression().IsIdentifier(t).ShouldHaveName("i")
+	infix.ShouldHaveOperator(">")
+
+	block := untilStatement.CodeBlock()
+	block.NthStmt(1).IsExpression(t).IsAssignExpression(t).NthVariable(1).IsIdentifier(t).ShouldHaveName("i")
+}
+
+func TestWhileModifier(t *testing.T) {
+	tests := []struct {
+		input string
+		until bool
+	}{
+		{`i += 1 while i < 10`, false},
+		{`i += 1 until i < 10`, true},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		program, err := p.ParseProgram()
+
+		if err != nil {
+			t.Fatal(err.Message)
+		}
+
+		whileStatement := program.FirstStmt().IsWhileStmt(t)
+
+		if whileStatement.Until != tt.until {
+			t.Fatalf("Expect until to be %t. got: %t", tt.until, whileStatement.Until)
+		}
+
+		infix := whileStatement.ConditionExpression().IsInfixExpression(t)
+		infix.TestableLeftExpression().IsIdentifier(t).ShouldHaveName("i")
+		infix.ShouldHaveOperator("<")
+
+		block := whileStatement.CodeBlock()
+		block.NthStmt(1).IsExpression(t).IsAssignExpression(t).NthVariable(1).IsIdentifier(t).ShouldHaveName("i")
+	}
 }
 
 func TestInvalidMethodNameFail(t *testing.T) {
@@ -21,29 +21,39 @@ const (
 	Int              = "INT"
 	Float            = "FLOAT"
 	String           = "STRING"
+	Symbol           = "SYMBOL"
+	Regexp           = "REGEXP"
 	Comment          = "COMMENT"
 
-	Assign   = "="
-	Plus     = "+"
-	PlusEq   = "+="
-	Minus    = "-"
-	MinusEq  = "-="
-	Bang     = "!"
-	Asterisk = "*"
-	Pow      = "**"
-	Slash    = "/"
-	Dot      = "."
-	And      = "&&"
-	Or       = "||"
-	OrEq     = "||="
-	Modulo   = "%"
-
-	Match = "=~"
-	LT    = "<"
-	LTE   = "<="
-	GT    = ">"
-	GTE   = ">="
-	COMP  = "<=>"
+	Assign     = "="
+	Plus       = "+"
+	PlusEq     = "+="
+	Minus      = "-"
+	MinusEq    = "-="
+	Arrow      = "->"
+	Bang       = "!"
+	Asterisk   = "*"
+	AsteriskEq = "*="
+	Pow        = "**"
+	Slash      = "/"
+	SlashEq    = "/="
+	Dot        = "."
+	Ampersand  = "&"
+	And        = "&&"
+	Or         = "||"
+	OrEq       = "||="
+	AndEq      = "&&="
+	Modulo     = "%"
+	ModuloEq   = "%="
+	Question   = "?"
+
+	Match  = "=~"
+	LT     = "<"
+	LTE    = "<="
+	LShift = "<<"
+	GT     = ">"
+	GTE    = ">="
+	COMP   = "<=>"
 
 	Comma     = ","
 	Semicolon = ";"
@@ -57,14 +67,16 @@ const (
 	LBracket = "["
 	RBracket = "]"
 
-	Eq    = "=="
-	NotEq = "!="
-	Range = ".."
+	Eq             = "=="
+	NotEq          = "!="
+	Range          = ".."
+	ExclusiveRange = "..."
 
 	True     = "TRUE"
 	False    = "FALSE"
 	Null     = "Null"
 	If       = "IF"
+	Unless   = "UNLESS"
 	ElsIf    = "ELSIF"
 	Else     = "ELSE"
 	Case     = "CASE"
@@ -76,11 +88,15 @@ const (
 	Self     = "SELF"
 	End      = "END"
 	While    = "WHILE"
+	Until    = "UNTIL"
 	Do       = "DO"
 	Yield    = "YIELD"
 	GetBlock = "GET_BLOCK"
 	Class    = "CLASS"
 	Module   = "MODULE"
+	// `and` and `or` work like `&&` and `||` with a lower precedence
+	AndKeyword = "AND"
+	OrKeyword  = "OR"
 
 	ResolutionOperator = "::"
 )
@@ -91,6 +107,7 @@ var keywords = map[string]Type{
 	"false":     False,
 	"nil":       Null,
 	"if":        If,
+	"unless":    Unless,
 	"elsif":     ElsIf,
 	"else":      Else,
 	"case":      Case,
@@ -99,6 +116,7 @@ var keywords = map[string]Type{
 	"self":      Self,
 	"end":       End,
 	"while":     While,
+	"until":     Until,
 	"do":        Do,
 	"yield":     Yield,
 	"next":      Next,
@@ -106,6 +124,8 @@ var keywords = map[string]Type{
 	"module":    Module,
 	"break":     Break,
 	"get_block": GetBlock,
+	"and":       AndKeyword,
+	"or":        OrKeyword,
 }
 
 var operators = map[string]Type{
@@ -114,26 +134,35 @@ var operators = map[string]Type{
 	"+=":  PlusEq,
 	"-":   Minus,
 	"-=":  MinusEq,
+	"->":  Arrow,
 	"!":   Bang,
 	"*":   Asterisk,
+	"*=":  AsteriskEq,
 	"**":  Pow,
 	"/":   Slash,
+	"/=":  SlashEq,
 	".":   Dot,
+	"&":   Ampersand,
 	"&&":  And,
 	"||":  Or,
 	"||=": OrEq,
+	"&&=": AndEq,
 	"%":   Modulo,
+	"%=":  ModuloEq,
+	"?":   Question,
 
 	"=~":  Match,
 	"<":   LT,
 	"<=":  LTE,
+	"<<":  LShift,
 	">":   GT,
 	">=":  GTE,
 	"<=>": COMP,
 
-	"==": Eq,
-	"!=": NotEq,
-	"..": Range,
+	"==":  Eq,
+	"!=":  NotEq,
+	"..":  Range,
+	"...": ExclusiveRange,
 
 	"::": ResolutionOperator,
 }
@@ -15,6 +15,7 @@ func TestLookupIdentTrue(t *testing.T) {
 		"true":      True,
 		"false":     False,
 		"if":        If,
+		"unless":    Unless,
 		"elsif":     ElsIf,
 		"else":      Else,
 		"when":      When,
@@ -24,10 +25,13 @@ func TestLookupIdentTrue(t *testing.T) {
 		"self":      Self,
 		"end":       End,
 		"while":     While,
+		"until":     Until,
 		"do":        Do,
 		"yield":     Yield,
 		"nil":       Null,
 		"get_block": GetBlock,
+		"and":       AndKeyword,
+		"or":        OrKeyword,
 	}
 
 	for name, token := range keywords {
@@ -69,13 +73,15 @@ func TestCreateOperatorIdentTrue(t *testing.T) {
 		"=~":  Match,
 		"<":   LT,
 		"<=":  LTE,
+		"<<":  LShift,
 		">":   GT,
 		">=":  GTE,
 		"<=>": COMP,
 
-		"==": Eq,
-		"!=": NotEq,
-		"..": Range,
+		"==":  Eq,
+		"!=":  NotEq,
+		"..":  Range,
+		"...": ExclusiveRange,
 
 		"::": ResolutionOperator,
 	}
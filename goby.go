@@ -55,11 +55,13 @@ func main() {
 		flag.Usage()
 		os.Exit(0)
 	case "test":
-		args := flag.Args()[1:]
 		filePath := flag.Arg(1)
 		fileInfo, err := os.Stat(filePath)
 		reportErrorAndExit(err)
 
+		// ARGV only has the arguments after the file path, like running a file normally
+		args := flag.Args()[2:]
+
 		dir := extractDirFromFilePath(filePath, fileInfo)
 		v, err := vm.New(dir, args)
 
@@ -267,8 +267,16 @@ func convertLex(t token.Type) string {
 	switch t {
 	case token.Asterisk:
 		s = "asterisk"
+	case token.AsteriskEq:
+		s = "asteriskeq"
+	case token.Ampersand:
+		s = "ampersand"
 	case token.And:
 		s = "and"
+	case token.AndEq:
+		s = "andeq"
+	case token.Arrow:
+		s = "arrow"
 	case token.Assign:
 		s = "assign"
 	case token.Bang:
@@ -307,6 +315,8 @@ func convertLex(t token.Type) string {
 		s = "minuseq"
 	case token.Modulo:
 		s = "modulo"
+	case token.ModuloEq:
+		s = "moduloeq"
 	case token.NotEq:
 		s = "noteq"
 	case token.Or:
@@ -333,6 +343,8 @@ func convertLex(t token.Type) string {
 		s = "semicolon"
 	case token.Slash:
 		s = "slash"
+	case token.SlashEq:
+		s = "slasheq"
 	default:
 		s = strings.ToLower(string(t))
 	}
@@ -24,6 +24,9 @@ for d in $(go list ./...); do
           cat profile.out >> coverage.txt
           rm profile.out
         fi
+
+        # Tests for threads and their synchronization always run with race detection
+        go test -race $d -run 'Thread|Channel|Mutex'
         continue
     fi
     # TODO: Add -race flag back when ready
@@ -75,16 +75,27 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// a[-7, 0] #=> ArgumentError: Index value -7 too small for array. minimum: -6
 		// ```
 		//
+		// A `Range` object can also be passed to retrieve the elements within the range.
+		// See `#slice` for the details.
+		//
+		// ```ruby
+		// a = [1, 2, 3, "a", "b", "c"]
+		// a[1..3]   #=> [2, 3, "a"]
+		// a[-2..-1] #=> ["b", "c"]
+		// a[6..7]   #=> []
+		// a[7..8]   #=> nil
+		// ```
+		//
 		// Note:
 		// * The notations such as `a.[](1)` or `a.[] 1` are unsupported.
-		// * `Range` object is unsupported for now.
 		//
 		// @param index [Integer], (count [Integer])
+		// @param range [Range]
 		// @return [Array]
 		Name: "[]",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			arr := receiver.(*ArrayObject)
-			return arr.index(t, args, sourceLine)
+			return arr.slice(t, args, sourceLine)
 		},
 	},
 	{
@@ -120,10 +131,12 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// Concatenation: returns a new array by just concatenating the two arrays.
+		// Neither the receiver nor the argument is modified.
 		//
 		// ```ruby
 		// a = [1, 2]
-		// b + [3, 4]  #=> [1, 2, 3, 4]
+		// a + [3, 4]  #=> [1, 2, 3, 4]
+		// a           #=> [1, 2]
 		// ```
 		//
 		// @param array [Array]
@@ -143,13 +156,182 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 			selfArray := receiver.(*ArrayObject)
 
-			newArrayElements := append(selfArray.Elements, otherArray.Elements...)
+			newArrayElements := make([]Object, 0, len(selfArray.Elements)+len(otherArray.Elements))
+			newArrayElements = append(newArrayElements, selfArray.Elements...)
+			newArrayElements = append(newArrayElements, otherArray.Elements...)
 
 			newArray := t.vm.InitArrayObject(newArrayElements)
 
 			return newArray
 		},
 	},
+	{
+		// Difference: returns a new array of the elements of self that are not included in the given array.
+		// The elements are compared with `==`, and the order of self is kept.
+		//
+		// ```ruby
+		// [1, 2, 3, 2] - [2]       #=> [1, 3]
+		// [1, "a", :b] - ["b", 1]  #=> ["a"]
+		// ```
+		//
+		// @param array [Array]
+		// @return [Array]
+		Name: "-",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			other, ok := args[0].(*ArrayObject)
+
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.ArrayClass, args[0].Class().Name)
+			}
+
+			elements := []Object{}
+
+			for _, obj := range receiver.(*ArrayObject).Elements {
+				if !other.includes(t, obj, sourceLine) {
+					elements = append(elements, obj)
+				}
+			}
+
+			return t.vm.InitArrayObject(elements)
+
+		},
+	},
+	{
+		// Intersection: returns a new array of the elements common to self and the given array, without duplicates.
+		// The elements are compared with `==`, and the order of self is kept.
+		//
+		// ```ruby
+		// [1, 1, 3, 5] & [3, 2, 1]  #=> [1, 3]
+		// [1, 2] & [3]              #=> []
+		// ```
+		//
+		// @param array [Array]
+		// @return [Array]
+		Name: "&",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			other, ok := args[0].(*ArrayObject)
+
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.ArrayClass, args[0].Class().Name)
+			}
+
+			result := t.vm.InitArrayObject([]Object{})
+
+			for _, obj := range receiver.(*ArrayObject).Elements {
+				if other.includes(t, obj, sourceLine) && !result.includes(t, obj, sourceLine) {
+					result.Elements = append(result.Elements, obj)
+				}
+			}
+
+			return result
+
+		},
+	},
+	{
+		// Union: returns a new array by joining self with the given array, without duplicates.
+		// The elements are compared with `==`, and the order is kept.
+		//
+		// ```ruby
+		// [1, 2, 2] | [2, 3]  #=> [1, 2, 3]
+		// ["a"] | ["b", "a"]  #=> ["a", "b"]
+		// ```
+		//
+		// @param array [Array]
+		// @return [Array]
+		Name: "|",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			other, ok := args[0].(*ArrayObject)
+
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.ArrayClass, args[0].Class().Name)
+			}
+
+			result := t.vm.InitArrayObject([]Object{})
+
+			for _, elements := range [][]Object{receiver.(*ArrayObject).Elements, other.Elements} {
+				for _, obj := range elements {
+					if !result.includes(t, obj, sourceLine) {
+						result.Elements = append(result.Elements, obj)
+					}
+				}
+			}
+
+			return result
+
+		},
+	},
+	{
+		// Appends the given object to the end of self, and returns self so the calls can be chained.
+		// The method is destructive and the self is mutated.
+		//
+		// ```ruby
+		// a = [1, 2]
+		// a << 3        #=> [1, 2, 3]
+		// a << 4 << 5   #=> [1, 2, 3, 4, 5]
+		// a << nil      #=> [1, 2, 3, 4, 5, nil]
+		// ```
+		//
+		// @param object [Object]
+		// @return [Array]
+		Name: "<<",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			return arr.push(args)
+
+		},
+	},
+	{
+		// Compares two arrays element by element with the elements' `<=>`, and returns -1, 0, or 1 at the first pair of different elements.
+		// If all the compared elements are equal, the shorter array is the smaller one.
+		// Returns nil if the Object is not an Array, or if any compared elements are incomparable.
+		//
+		// ```ruby
+		// [1, 2] <=> [1, 3]        # => -1
+		// [1, 2] <=> [1, 2]        # => 0
+		// [1, 2, 3] <=> [1, 2]     # => 1
+		// [[1, 2], 3] <=> [[1], 4] # => 1
+		// [1, 2] <=> [1, "a"]      # => nil
+		// [1, 2] <=> 1             # => nil
+		// ```
+		//
+		// @param array [Array]
+		// @return [Integer]
+		Name: "<=>",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			other, ok := args[0].(*ArrayObject)
+			if !ok {
+				return NULL
+			}
+
+			return arr.compare(t, other, sourceLine)
+
+		},
+	},
 	{
 		// Assigns one or more values to an array. It requires one or two indices and a value as argument.
 		// The first index should be Integer, and the second index should be zero or positive integer.
@@ -222,6 +404,10 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		Name: "[]=",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			// First argument is an index: there exists two cases which will be described in the following code
 			aLen := len(args)
 			if aLen < 2 || aLen > 3 {
@@ -331,8 +517,41 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// A predicate method.
-		// Evaluates the given block and returns `true` if the block ever returns a value.
-		// Returns `false` if the evaluated block returns `false` or `nil`.
+		// Evaluates the given block with each element and returns `true` if the block never returns `false` or `nil`.
+		// If no block is given, the elements themselves are evaluated instead.
+		// Returns `true` for an empty array.
+		// The iteration stops at the first element that fails.
+		//
+		// ```ruby
+		// [1, 2, 3].all? do |e|
+		//   e > 0
+		// end            #=> true
+		// [1, 2, 3].all? do |e|
+		//   e > 1
+		// end            #=> false
+		// [1, nil].all?  #=> false
+		// [].all?        #=> true
+		// ```
+		//
+		// @param block [Block]
+		// @return [Boolean]
+		Name: "all?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			return toBooleanObject(!arr.anyMatch(t, blockFrame, false))
+
+		},
+	},
+	{
+		// A predicate method.
+		// Evaluates the given block with each element and returns `true` if the block ever returns a truthy value.
+		// Returns `false` if the evaluated block always returns `false` or `nil`.
+		// If no block is given, the elements themselves are evaluated instead.
+		// The iteration stops at the first element that passes.
 		//
 		// ```ruby
 		// a = [1, 2, 3]
@@ -349,41 +568,48 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// a.any? do |e|
 		//   nil
 		// end            #=> false
+		// a.any?         #=> true
 		//
 		// a = []
 		//
 		// a.any? do |e|
 		//   true
 		// end            #=> false
+		//
+		// [nil, false].any? #=> false
 		// ```
 		//
 		// @param block [Block]
 		// @return [Boolean]
 		Name: "any?",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			arr := receiver.(*ArrayObject)
-
-			if blockFrame == nil {
-				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
-			}
-
-			if blockIsEmpty(blockFrame) {
-				return FALSE
-			}
-
-			if len(arr.Elements) == 0 {
-				t.callFrameStack.pop()
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
 
-			for _, obj := range arr.Elements {
-				result := t.builtinMethodYield(blockFrame, obj)
+			arr := receiver.(*ArrayObject)
+			return toBooleanObject(arr.anyMatch(t, blockFrame, true))
 
-				if result.Target.isTruthy() {
-					return TRUE
-				}
+		},
+	},
+	{
+		// Same as `push`.
+		//
+		// ```ruby
+		// a = [1, 2]
+		// a.append(3, 4)  #=> [1, 2, 3, 4]
+		// ```
+		//
+		// @param object [Object]...
+		// @return [Array]
+		Name: "append",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
 			}
 
-			return FALSE
+			arr := receiver.(*ArrayObject)
+			return arr.push(args)
 
 		},
 	},
@@ -412,6 +638,65 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Splits self into chunks of consecutive elements and returns them as a new array of arrays.
+		// The given block is evaluated with each pair of adjacent elements `|prev, curr|`,
+		// and a new chunk is started whenever the block returns a falsy value.
+		// A block literal is required.
+		//
+		// ```ruby
+		// [1, 2, 4, 5, 7].chunk_while do |a, b|
+		//   b - a == 1
+		// end
+		// #=> [[1, 2], [4, 5], [7]]
+		//
+		// [1].chunk_while do |a, b|
+		//   false
+		// end
+		// #=> [[1]]
+		// ```
+		//
+		// @param block literal
+		// @return [Array]
+		Name: "chunk_while",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			arr := receiver.(*ArrayObject)
+			// If there's no pair of adjacent elements, pop the block's call frame
+			if len(arr.Elements) < 2 {
+				t.callFrameStack.pop()
+			}
+
+			if len(arr.Elements) == 0 {
+				return t.vm.InitArrayObject([]Object{})
+			}
+
+			emptyBlock := blockIsEmpty(blockFrame)
+			chunks := []Object{}
+			chunk := []Object{arr.Elements[0]}
+			for i := 1; i < len(arr.Elements); i++ {
+				prev, curr := arr.Elements[i-1], arr.Elements[i]
+				if !emptyBlock && t.builtinMethodYield(blockFrame, prev, curr).Target.isTruthy() {
+					chunk = append(chunk, curr)
+					continue
+				}
+
+				chunks = append(chunks, t.vm.InitArrayObject(chunk))
+				chunk = []Object{curr}
+			}
+			chunks = append(chunks, t.vm.InitArrayObject(chunk))
+
+			return t.vm.InitArrayObject(chunks)
+
+		},
+	},
 	{
 		// Removes all elements in the array and returns an empty array.
 		//
@@ -424,6 +709,10 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// @return [Array]
 		Name: "clear",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 0 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
@@ -435,71 +724,162 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Concatenation: returns a new array by just concatenating the arrays.
-		// Empty or multiple arrays can be taken.
+		// Returns a new array of all combinations of `n` elements of self.
+		// The combinations are ordered by the indices of the elements, and each element is chosen at most once.
+		// Returns an empty array if `n` is bigger than the size of self.
+		// If a block is given, yields each combination to the block and returns self instead.
 		//
 		// ```ruby
 		// a = [1, 2, 3]
-		// a.concat([4, 5, 6])
-		// a #=> [1, 2, 3, 4, 5, 6]
-		//
-		// [1, 2, 3].concat([])                 #=> [1, 2, 3]
+		// a.combination(2) #=> [[1, 2], [1, 3], [2, 3]]
+		// a.combination(0) #=> [[]]
+		// a.combination(4) #=> []
 		//
-		// [1, 2, 3].concat([4, 5], [6, 7], []) #=> [1, 2, 3, 4, 5, 6, 7]
+		// a.combination(2) do |c|
+		//   puts(c)
+		// end
+		// #=> [1, 2]
+		// #=> [1, 3]
+		// #=> [2, 3]
 		// ```
 		//
-		// @param array [Array]
+		// @param n [Integer], (block literal)
 		// @return [Array]
-		Name: "concat",
+		Name: "combination",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			arr := receiver.(*ArrayObject)
-
-			for _, arg := range args {
-				addAr, ok := arg.(*ArrayObject)
-
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.ArrayClass, arg.Class().Name)
-				}
-
-				for _, el := range addAr.Elements {
-					arr.Elements = append(arr.Elements, el)
-				}
-			}
-
-			return arr
+			return arr.tuples(t, args, blockFrame, sourceLine, false)
 
 		},
 	},
 	{
-		// If no block is given, just returns the count of the elements within the array.
-		// If a block is given, evaluate each element of the array by the given block,
-		// and then return the count of elements that return `true` by the block.
+		// Returns a new array with all `nil` elements removed. The order of the remaining elements is kept.
 		//
 		// ```ruby
-		// a = [1, 2, 3, 4, 5]
-		//
-		// a.count do |e|
-		//   e * 2 > 3
-		// end
-		// #=> 4
+		// a = [1, nil, "a", nil, 2]
+		// a.compact #=> [1, "a", 2]
+		// a         #=> [1, nil, "a", nil, 2]
 		// ```
 		//
-		// @param
-		// @param block [Block]
-		// @return [Integer]
-		Name: "count",
+		// @return [Array]
+		Name: "compact",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			aLen := len(args)
-			if aLen > 1 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
 
 			arr := receiver.(*ArrayObject)
-			var count int
-			if blockFrame != nil {
-				if blockIsEmpty(blockFrame) {
-					return t.vm.InitIntegerObject(0)
-				}
+			return t.vm.InitArrayObject(arr.compact())
+
+		},
+	},
+	{
+		// A destructive method.
+		// Removes all `nil` elements from self and returns self.
+		// Returns `nil` if no elements have been removed.
+		//
+		// ```ruby
+		// a = [1, nil, "a", nil, 2]
+		// a.compact! #=> [1, "a", 2]
+		// a          #=> [1, "a", 2]
+		// a.compact! #=> nil
+		// ```
+		//
+		// @return [Array]
+		Name: "compact!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			elements := arr.compact()
+			if len(elements) == len(arr.Elements) {
+				return NULL
+			}
+
+			arr.Elements = elements
+			return arr
+
+		},
+	},
+	{
+		// Appends the elements of the given arrays to self, and returns self.
+		// Empty or multiple arrays can be taken.
+		// The method is destructive and the self is mutated.
+		// Raises a TypeError without modifying self if any of the arguments is not an array.
+		//
+		// ```ruby
+		// a = [1, 2, 3]
+		// a.concat([4, 5, 6])
+		// a #=> [1, 2, 3, 4, 5, 6]
+		//
+		// [1, 2, 3].concat([])                 #=> [1, 2, 3]
+		//
+		// [1, 2, 3].concat([4, 5], [6, 7], []) #=> [1, 2, 3, 4, 5, 6, 7]
+		// ```
+		//
+		// @param array [Array]
+		// @return [Array]
+		Name: "concat",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			arr := receiver.(*ArrayObject)
+			elements := []Object{}
+
+			for _, arg := range args {
+				addAr, ok := arg.(*ArrayObject)
+
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.ArrayClass, arg.Class().Name)
+				}
+
+				elements = append(elements, addAr.Elements...)
+			}
+
+			arr.Elements = append(arr.Elements, elements...)
+
+			return arr
+
+		},
+	},
+	{
+		// If no block is given, just returns the count of the elements within the array.
+		// If a block is given, evaluate each element of the array by the given block,
+		// and then return the count of elements that return `true` by the block.
+		//
+		// ```ruby
+		// a = [1, 2, 3, 4, 5]
+		//
+		// a.count do |e|
+		//   e * 2 > 3
+		// end
+		// #=> 4
+		// ```
+		//
+		// @param
+		// @param block [Block]
+		// @return [Integer]
+		Name: "count",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			aLen := len(args)
+			if aLen > 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
+			}
+
+			arr := receiver.(*ArrayObject)
+			var count int
+			if blockFrame != nil {
+				if blockIsEmpty(blockFrame) {
+					return t.vm.InitIntegerObject(0)
+				}
 				if len(arr.Elements) == 0 {
 					t.callFrameStack.pop()
 				}
@@ -545,6 +925,63 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// A destructive method.
+		// Deletes all elements that equal to the given object from self, and returns the last deleted element.
+		// Returns `nil` if no elements matched. If a block is given and no elements matched,
+		// returns the result of the block instead.
+		//
+		// ```ruby
+		// a = [1, 2, 1, 3]
+		// a.delete(1) #=> 1
+		// a           #=> [2, 3]
+		// a.delete(5) #=> nil
+		//
+		// a.delete(5) do
+		//   "missing"
+		// end
+		// #=> "missing"
+		// ```
+		//
+		// @param object [Object]
+		// @return [Object]
+		Name: "delete",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			var deleted Object
+			elements := []Object{}
+			for _, obj := range arr.Elements {
+				if t.isEqual(obj, args[0], sourceLine) {
+					deleted = obj
+				} else {
+					elements = append(elements, obj)
+				}
+			}
+
+			if deleted != nil {
+				arr.Elements = elements
+				return deleted
+			}
+
+			if blockFrame != nil {
+				if blockIsEmpty(blockFrame) {
+					return NULL
+				}
+				return t.builtinMethodYield(blockFrame, args[0]).Target
+			}
+
+			return NULL
+
+		},
+	},
 	{
 		// Deletes the element pointed by the given index.
 		// Returns the removed element.
@@ -556,12 +993,18 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// a.delete_at(1) #=> "b"
 		// a.delete_at(-1) #=> "c"
 		// a       #=> ["a"]
+		// a.delete_at(5) #=> nil
+		// a       #=> ["a"]
 		// ```
 		//
 		// @param index [Integer]
 		// @return [Object]
 		Name: "delete_at",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 1 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
 			}
@@ -584,12 +1027,34 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 			deletedValue := arr.Elements[normalizedIndex]
 
-			arr.Elements = append(arr.Elements[:normalizedIndex], arr.Elements[normalizedIndex+1:]...)
+			// Build a new slice so arrays sharing the same backing array (e.g. from `first`) stay untouched
+			elements := make([]Object, 0, len(arr.Elements)-1)
+			elements = append(elements, arr.Elements[:normalizedIndex]...)
+			arr.Elements = append(elements, arr.Elements[normalizedIndex+1:]...)
 
 			return deletedValue
 
 		},
 	},
+	{
+		// An alias of #find.
+		//
+		// ```ruby
+		// [1, 2, 3, 4].detect do |x|
+		//   x > 2
+		// end
+		// #=> 3
+		// ```
+		//
+		// @param block literal
+		// @return [Object]
+		Name: "detect",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.find(t, args, blockFrame, sourceLine)
+
+		},
+	},
 	{
 		// Returns the value from the nested array, specified by one or more indices,
 		// Returns `nil` if one of the intermediate values are `nil`.
@@ -599,6 +1064,7 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// [[], 2].dig(0, 1)    #=> nil
 		// [[], 2].dig(0, 1, 2) #=> nil
 		// [[1, 2, [3, [8, [9]]]], 4, 5].dig(0, 2, 1, 1, 0) #=> 9
+		// [[nil], 2].dig(0, 0, 1) #=> nil
 		// [1, 2].dig(0, 1)     #=> TypeError: Expect target to be Diggable
 		// ```
 		//
@@ -617,6 +1083,44 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Drops the leading elements while the given block returns truthy value,
+		// and returns a new array that contains the rest of the elements.
+		// The receiver is not mutated. A block literal is required.
+		//
+		// ```ruby
+		// [1, 2, 3, 1].drop_while do |x|
+		//   x < 3
+		// end
+		// #=> [3, 1]
+		// ```
+		//
+		// @param block literal
+		// @return [Array]
+		Name: "drop_while",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			arr := receiver.(*ArrayObject)
+			// If it's an empty array, pop the block's call frame
+			if len(arr.Elements) == 0 {
+				t.callFrameStack.pop()
+			}
+
+			n := arr.countWhile(t, blockFrame)
+			elements := make([]Object, len(arr.Elements)-n)
+			copy(elements, arr.Elements[n:])
+
+			return t.vm.InitArrayObject(elements)
+
+		},
+	},
 	{
 		Name: "dup",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
@@ -676,6 +1180,33 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns a new array of every consecutive window of `n` elements of self.
+		// Returns an empty array if `n` is bigger than the size of self.
+		// If a block is given, yields each window to the block and returns self instead.
+		// `n` should be a positive integer.
+		//
+		// ```ruby
+		// a = [1, 2, 3, 4]
+		// a.each_cons(2) #=> [[1, 2], [2, 3], [3, 4]]
+		// a.each_cons(5) #=> []
+		//
+		// a.each_cons(3) do |w|
+		//   puts(w)
+		// end
+		// #=> [1, 2, 3]
+		// #=> [2, 3, 4]
+		// ```
+		//
+		// @param n [Integer], (block literal)
+		// @return [Array]
+		Name: "each_cons",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.eachWindow(t, args, blockFrame, sourceLine, true)
+
+		},
+	},
 	// Works like #each, but passes the index of the element instead of the element itself.
 	// Returns self.
 	// A block literal is required.
@@ -724,6 +1255,127 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Splits self into slices of `n` elements, and returns them as a new array of arrays.
+		// The last slice may have less than `n` elements.
+		// If a block is given, yields each slice to the block and returns self instead.
+		// `n` should be a positive integer.
+		//
+		// ```ruby
+		// a = [1, 2, 3, 4, 5]
+		// a.each_slice(2) #=> [[1, 2], [3, 4], [5]]
+		//
+		// a.each_slice(2) do |s|
+		//   puts(s)
+		// end
+		// #=> [1, 2]
+		// #=> [3, 4]
+		// #=> [5]
+		// ```
+		//
+		// @param n [Integer], (block literal)
+		// @return [Array]
+		Name: "each_slice",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.eachWindow(t, args, blockFrame, sourceLine, false)
+
+		},
+	},
+	{
+		// Works like #each, but passes the element and its index to the block.
+		// Returns self.
+		// A block literal is required.
+		//
+		// ```ruby
+		// a = [:apple, :orange, :grape]
+		//
+		// b = a.each_with_index do |e, i|
+		//   puts(i.to_s + ": " + e)
+		// end
+		// #=> 0: apple
+		// #=> 1: orange
+		// #=> 2: grape
+		// puts b
+		// #=> ["apple", "orange", "grape"]
+		// ```
+		//
+		// @param block literal
+		// @return [Array]
+		Name: "each_with_index",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			arr := receiver.(*ArrayObject)
+			if blockIsEmpty(blockFrame) {
+				return arr
+			}
+
+			// If it's an empty array, pop the block's call frame
+			if len(arr.Elements) == 0 {
+				t.callFrameStack.pop()
+			}
+
+			for i, obj := range arr.Elements {
+				t.builtinMethodYield(blockFrame, obj, t.vm.InitIntegerObject(i))
+			}
+			return arr
+
+		},
+	},
+	{
+		// Loops through each element with the given block, passing the element and the given memo object to the block.
+		// Returns the memo object. The return value of the block is ignored.
+		// A memo object and a block literal are required.
+		//
+		// ```ruby
+		// [1, 2, 3].each_with_object([]) do |e, acc|
+		//   acc.push(e * 2)
+		// end
+		// #=> [2, 4, 6]
+		//
+		// [:a, :b].each_with_object({}) do |e, h|
+		//   h[e] = e + e
+		// end
+		// #=> { a: "aa", b: "bb" }
+		// ```
+		//
+		// @param memo [Object], block literal
+		// @return [Object]
+		Name: "each_with_object",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			arr := receiver.(*ArrayObject)
+			memo := args[0]
+			if blockIsEmpty(blockFrame) {
+				return memo
+			}
+
+			// If it's an empty array, pop the block's call frame
+			if len(arr.Elements) == 0 {
+				t.callFrameStack.pop()
+			}
+
+			for _, obj := range arr.Elements {
+				t.builtinMethodYield(blockFrame, obj, memo)
+			}
+			return memo
+
+		},
+	},
 	{
 		// A predicate method.
 		// Returns if the array"s length is 0 or not.
@@ -742,12 +1394,60 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 			}
 
 			arr := receiver.(*ArrayObject)
-
-			if arr.Len() == 0 {
-				return TRUE
-			}
-
-			return FALSE
+
+			if arr.Len() == 0 {
+				return TRUE
+			}
+
+			return FALSE
+
+		},
+	},
+	{
+		// Returns the first element that the given block returns truthy value, or `nil` if no elements match.
+		// The iteration stops as soon as a matched element is found.
+		// A block literal is required.
+		//
+		// ```ruby
+		// [1, 2, 3, 4].find do |x|
+		//   x > 2
+		// end
+		// #=> 3
+		//
+		// [1, 2, 3, 4].find do |x|
+		//   x > 5
+		// end
+		// #=> nil
+		// ```
+		//
+		// @param block literal
+		// @return [Object]
+		Name: "find",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.find(t, args, blockFrame, sourceLine)
+
+		},
+	},
+	{
+		// An alias of #index.
+		//
+		// ```ruby
+		// [10, 20, 30].find_index(30) #=> 2
+		//
+		// [1, 2, 3, 4].find_index do |x|
+		//   x.even?
+		// end
+		// #=> 1
+		// ```
+		//
+		// @param object [Object]
+		// @param block literal
+		// @return [Integer]
+		Name: "find_index",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.findIndex(t, args, blockFrame, sourceLine)
 
 		},
 	},
@@ -799,6 +1499,8 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// Returns a new array that is a one-dimensional flattening of self.
+		// An optional Integer can be passed to specify the recursion depth of the flattening.
+		// A negative depth flattens the array completely, as the same as passing no argument.
 		//
 		// ```ruby
 		// a = [ 1, 2, 3 ]
@@ -808,19 +1510,87 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		//
 		// [[[1, 2], [[[3, 4]], [5, 6]]]].flatten
 		// #=> [1, 2, 3, 4, 5, 6]
+		//
+		// [[1, [2, 3]]].flatten(1) #=> [1, [2, 3]]
+		// [[1, [2, 3]], nil].flatten(0) #=> [[1, [2, 3]], nil]
 		// ```
 		//
+		// @param depth [Integer]
 		// @return [Array]
 		Name: "flatten",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 0 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			return receiver.(*ArrayObject).flattenByArgs(t, args, sourceLine)
+
+		},
+	},
+	{
+		// A destructive method.
+		// Flattens self in place as `flatten` does, and returns self.
+		// Returns `nil` if no elements have been flattened.
+		//
+		// ```ruby
+		// a = [1, [2, [3]]]
+		// a.flatten!     #=> [1, 2, 3]
+		// a              #=> [1, 2, 3]
+		// a.flatten!     #=> nil
+		//
+		// [1, [2, [3]]].flatten!(1) #=> [1, 2, [3]]
+		// ```
+		//
+		// @param depth [Integer]
+		// @return [Array]
+		Name: "flatten!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
 			}
 
 			arr := receiver.(*ArrayObject)
-			newElements := arr.flatten()
+			result := arr.flattenByArgs(t, args, sourceLine)
+			flattened, ok := result.(*ArrayObject)
+
+			if !ok {
+				return result
+			}
+
+			// Flattening an element always changes the length or the element at its position
+			changed := len(flattened.Elements) != len(arr.Elements)
+
+			for i := 0; !changed && i < len(arr.Elements); i++ {
+				changed = flattened.Elements[i] != arr.Elements[i]
+			}
+
+			if !changed {
+				return NULL
+			}
 
-			return t.vm.InitArrayObject(newElements)
+			arr.Elements = flattened.Elements
+			return arr
+
+		},
+	},
+	{
+		// Returns the index of the first element that equals to the given object, or `nil` if not found.
+		// If a block is given, returns the index of the first element that the block returns truthy value.
+		// The argument is ignored when a block is given.
+		//
+		// ```ruby
+		// [10, 20, 30].index(20) #=> 1
+		// [10, 20, 30].index(40) #=> nil
+		//
+		// [1, 2, 3, 4].index do |x|
+		//   x > 2
+		// end
+		// #=> 2
+		// ```
+		//
+		// @param object [Object]
+		// @param block literal
+		// @return [Integer]
+		Name: "index",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.findIndex(t, args, blockFrame, sourceLine)
 
 		},
 	},
@@ -885,16 +1655,38 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// An alias of #reduce.
+		//
+		// ```ruby
+		// [1, 2, 3, 4].inject(0) do |sum, n|
+		//   sum + n
+		// end
+		// #=> 10
+		// ```
+		//
+		// @param initial value [Object], block literal with two block parameters
+		// @return [Object]
+		Name: "inject",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.reduce(t, args, blockFrame, sourceLine)
+
+		},
+	},
 	{
 		// Returns a string by concatenating each element to string, separated by given separator.
-		// If the array is nested, they will be flattened and then concatenated.
-		// If separator is nil, it uses empty string.
+		// If the array is nested, they will be flattened and then concatenated with the same separator.
+		// If no separator is given, it uses empty string.
+		// `nil` elements are converted to empty strings.
 		//
 		// ```ruby
 		// [ 1, 2, 3 ].join                #=> "123"
 		// [[:h, :e, :l], [[:l], :o]].join #=> "hello"
 		// [[:hello],{k: :v}].join         #=> 'hello{ k: "v" }'
 		// [ 1, 2, 3 ].join("-")           #=> "1-2-3"
+		// [1, "a", true].join("-")        #=> "1-a-true"
+		// [1, nil, 2].join(",")           #=> "1,,2"
 		// ```
 		//
 		// @param separator [String]
@@ -902,7 +1694,7 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		Name: "join",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			aLen := len(args)
-			if aLen < 0 || aLen > 1 {
+			if aLen > 1 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 0, 1, aLen)
 			}
 
@@ -929,6 +1721,65 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// A destructive method.
+		// Inserts the given values before the element of the given index, and then returns self.
+		// A negative index counts from the end of the array, and the values are inserted after the element of the index.
+		// If the index is bigger than the size of the array, the gaps will be filled with `nil`.
+		//
+		// ```ruby
+		// a = [1, 2, 3]
+		// a.insert(1, :a, :b) #=> [1, "a", "b", 2, 3]
+		// a.insert(-1, 4)     #=> [1, "a", "b", 2, 3, 4]
+		// a.insert(-3, :c)    #=> [1, "a", "b", 2, "c", 3, 4]
+		//
+		// [1].insert(3, 5)    #=> [1, nil, nil, 5]
+		// ```
+		//
+		// @param index [Integer], object [Object]...
+		// @return [Array]
+		Name: "insert",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			if len(args) < 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentMore, 1, len(args))
+			}
+
+			index, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			arr := receiver.(*ArrayObject)
+			values := args[1:]
+			if len(values) == 0 {
+				return arr
+			}
+
+			position := index.value
+			if position < 0 {
+				position = arr.Len() + position + 1
+				if position < 0 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.TooSmallIndexValue, index.value, -arr.Len()-1)
+				}
+			}
+
+			for arr.Len() < position {
+				arr.Elements = append(arr.Elements, NULL)
+			}
+
+			elements := make([]Object, 0, arr.Len()+len(values))
+			elements = append(elements, arr.Elements[:position]...)
+			elements = append(elements, values...)
+			arr.Elements = append(elements, arr.Elements[position:]...)
+
+			return arr
+
+		},
+	},
 	{
 		// Returns the last element of the array.
 		// If a count 'n' is provided as an argument, it returns the array of the last n elements.
@@ -1044,6 +1895,169 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns the element with the largest key computed from the given block, or `nil` if the array is empty.
+		// The keys should be all Numeric or all String objects; comparing incomparable keys returns an ArgumentError.
+		// If several elements have the largest key, the first one is returned.
+		// A block literal is required.
+		//
+		// ```ruby
+		// ["a", "ccc", "bb"].max_by do |s|
+		//   s.size
+		// end
+		// #=> "ccc"
+		// ```
+		//
+		// @param block literal
+		// @return [Object]
+		Name: "max_by",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.extremeBy(t, args, blockFrame, sourceLine, 1)
+
+		},
+	},
+	{
+		// Returns the element with the smallest key computed from the given block, or `nil` if the array is empty.
+		// The keys should be all Numeric or all String objects; comparing incomparable keys returns an ArgumentError.
+		// If several elements have the smallest key, the first one is returned.
+		// A block literal is required.
+		//
+		// ```ruby
+		// ["a", "ccc", "bb"].min_by do |s|
+		//   s.size
+		// end
+		// #=> "a"
+		// ```
+		//
+		// @param block literal
+		// @return [Object]
+		Name: "min_by",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.extremeBy(t, args, blockFrame, sourceLine, -1)
+
+		},
+	},
+	{
+		// A predicate method.
+		// Evaluates the given block with each element and returns `true` if the block never returns a truthy value.
+		// If no block is given, the elements themselves are evaluated instead.
+		// Returns `true` for an empty array.
+		// The iteration stops at the first element that passes.
+		//
+		// ```ruby
+		// [1, 2, 3].none? do |e|
+		//   e > 3
+		// end                  #=> true
+		// [1, 2, 3].none? do |e|
+		//   e > 2
+		// end                  #=> false
+		// [nil, false].none?   #=> true
+		// [].none?             #=> true
+		// ```
+		//
+		// @param block [Block]
+		// @return [Boolean]
+		Name: "none?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			return toBooleanObject(!arr.anyMatch(t, blockFrame, true))
+
+		},
+	},
+	{
+		// Loops through each element with the given block literal, and returns an array of two arrays:
+		// the first one contains the elements that the block returns truthy value,
+		// and the second one contains the rest of the elements. Both keep the original order.
+		// A block literal is required.
+		//
+		// ```ruby
+		// [1, 2, 3, 4, 5].partition do |x|
+		//   x > 2
+		// end
+		// #=> [[3, 4, 5], [1, 2]]
+		//
+		// [].partition do |x|
+		//   x > 2
+		// end
+		// #=> [[], []]
+		// ```
+		//
+		// @param block literal
+		// @return [Array]
+		Name: "partition",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			arr := receiver.(*ArrayObject)
+			// If it's an empty array, pop the block's call frame
+			if len(arr.Elements) == 0 {
+				t.callFrameStack.pop()
+			}
+
+			truthy := []Object{}
+			falsy := []Object{}
+			for _, obj := range arr.Elements {
+				if blockIsEmpty(blockFrame) {
+					falsy = append(falsy, obj)
+					continue
+				}
+
+				result := t.builtinMethodYield(blockFrame, obj)
+				if result.Target.isTruthy() {
+					truthy = append(truthy, obj)
+				} else {
+					falsy = append(falsy, obj)
+				}
+			}
+
+			return t.vm.InitArrayObject([]Object{t.vm.InitArrayObject(truthy), t.vm.InitArrayObject(falsy)})
+
+		},
+	},
+	{
+		// Returns a new array of all permutations of `n` elements of self.
+		// The permutations are ordered by the indices of the elements, and each element is chosen at most once.
+		// Returns an empty array if `n` is bigger than the size of self.
+		// If a block is given, yields each permutation to the block and returns self instead.
+		//
+		// ```ruby
+		// a = [1, 2, 3]
+		// a.permutation(2) #=> [[1, 2], [1, 3], [2, 1], [2, 3], [3, 1], [3, 2]]
+		// a.permutation(0) #=> [[]]
+		// a.permutation(4) #=> []
+		//
+		// a.permutation(2) do |p|
+		//   puts(p)
+		// end
+		// #=> [1, 2]
+		// #=> [1, 3]
+		// #=> [2, 1]
+		// #=> [2, 3]
+		// #=> [3, 1]
+		// #=> [3, 2]
+		// ```
+		//
+		// @param n [Integer], (block literal)
+		// @return [Array]
+		Name: "permutation",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.tuples(t, args, blockFrame, sourceLine, true)
+
+		},
+	},
 	{
 		// A destructive method.
 		// Removes the last element in the array and returns it.
@@ -1057,6 +2071,10 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// @return [Object]
 		Name: "pop",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 0 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
@@ -1066,6 +2084,57 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns a new array of arrays which is the cartesian product of self and the given arrays.
+		// Each inner array contains one element from self followed by one element from each of the given arrays.
+		// If no argument is given, returns each element of self wrapped in a single-element array.
+		//
+		// Note that the size of the result is the product of the sizes of all arrays,
+		// so it grows quickly; keep the arrays moderately sized.
+		//
+		// ```ruby
+		// [1, 2].product([3, 4])           #=> [[1, 3], [1, 4], [2, 3], [2, 4]]
+		// [1, 2].product([3], [5, 6])      #=> [[1, 3, 5], [1, 3, 6], [2, 3, 5], [2, 3, 6]]
+		// [1, 2].product                   #=> [[1], [2]]
+		// [1, 2].product([])               #=> []
+		// ```
+		//
+		// @param array [Array]...
+		// @return [Array]
+		Name: "product",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			arrays := []*ArrayObject{arr}
+			for _, arg := range args {
+				a, ok := arg.(*ArrayObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.ArrayClass, arg.Class().Name)
+				}
+				arrays = append(arrays, a)
+			}
+
+			tuples := [][]Object{{}}
+			for _, a := range arrays {
+				newTuples := make([][]Object, 0, len(tuples)*len(a.Elements))
+				for _, tuple := range tuples {
+					for _, obj := range a.Elements {
+						newTuple := make([]Object, len(tuple), len(tuple)+1)
+						copy(newTuple, tuple)
+						newTuples = append(newTuples, append(newTuple, obj))
+					}
+				}
+				tuples = newTuples
+			}
+
+			elements := make([]Object, len(tuples))
+			for i, tuple := range tuples {
+				elements[i] = t.vm.InitArrayObject(tuple)
+			}
+
+			return t.vm.InitArrayObject(elements)
+
+		},
+	},
 	{
 		// A destructive method.
 		// Appends the given object to the array and returns the array.
@@ -1088,6 +2157,10 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		Name: "push",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			arr := receiver.(*ArrayObject)
 			return arr.push(args)
 
@@ -1120,68 +2193,76 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		//   prev + s + " "
 		// end
 		// #=> "Yes, this is a test! "
+		//
+		// [].reduce(5) do |sum, n|
+		//   sum + n
+		// end
+		// #=> 5
+		//
+		// [].reduce do |sum, n|
+		//   sum + n
+		// end
+		// #=> nil
 		// ```
 		//
 		// @param initial value [Object], block literal with two block parameters
 		// @return [Object]
 		Name: "reduce",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			aLen := len(args)
-			if aLen > 1 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
-			}
-			if blockFrame == nil {
-				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
-			}
-
-			// If it's an empty array, pop the block's call frame
 			arr := receiver.(*ArrayObject)
-			if len(arr.Elements) == 0 {
-				t.callFrameStack.pop()
-			}
-
-			if blockIsEmpty(blockFrame) {
-				return NULL
-			}
-
-			var prev Object
-			var start int
-			switch aLen {
-			case 0:
-				prev = arr.Elements[0]
-				start = 1
-			case 1:
-				prev = args[0]
-				start = 0
-			}
+			return arr.reduce(t, args, blockFrame, sourceLine)
 
-			for i := start; i < len(arr.Elements); i++ {
-				result := t.builtinMethodYield(blockFrame, prev, arr.Elements[i])
-				prev = result.Target
+		},
+	},
+	{
+		// Returns a new array containing self‘s elements in reverse order. Not destructive.
+		//
+		// ```ruby
+		// a = [1, 2, 7]
+		//
+		// a.reverse #=> [7, 2, 1]
+		// ```
+		//
+		// @return [Array]
+		Name: "reverse",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
 
-			return prev
+			arr := receiver.(*ArrayObject)
+			return arr.reverse()
 
 		},
 	},
 	{
-		// Returns a new array containing self‘s elements in reverse order. Not destructive.
+		// A destructive method.
+		// Reverses the order of the elements in self, and then returns self.
 		//
 		// ```ruby
 		// a = [1, 2, 7]
 		//
-		// a.reverse #=> [7, 2, 1]
+		// a.reverse! #=> [7, 2, 1]
+		// a          #=> [7, 2, 1]
 		// ```
 		//
 		// @return [Array]
-		Name: "reverse",
+		Name: "reverse!",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 0 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
 
 			arr := receiver.(*ArrayObject)
-			return arr.reverse()
+			for i, j := 0, len(arr.Elements)-1; i < j; i, j = i+1, j-1 {
+				arr.Elements[i], arr.Elements[j] = arr.Elements[j], arr.Elements[i]
+			}
+
+			return arr
 
 		},
 	},
@@ -1236,8 +2317,9 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 	{
 		// Returns a new rotated array from the self.
 		// The method is not destructive.
-		// If zero `0` is passed, it returns a new array that has been rotated 1 time to left (default).
+		// If no argument is passed, it returns a new array that has been rotated 1 time to left (default).
 		// If an optional positive integer `n` is passed, it returns a new array that has been rotated `n` times to left.
+		// A count larger than the length of the array wraps around.
 		//
 		// ```ruby
 		// a = [:a, :b, :c, :d]
@@ -1253,44 +2335,100 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// a = [:a, :b, :c, :d]
 		//
 		// a.rotate(-1) #=> ["d", "a", "b", "c"]
+		// a.rotate(9)  #=> ["b", "c", "d", "a"]
 		// ```
 		//
 		// @param index [Integer]
 		// @return [Array]
 		Name: "rotate",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*ArrayObject).rotate(t, args, sourceLine)
+
+		},
+	},
+	{
+		// Rotates self in place, and returns self. Takes the same argument as `rotate`.
+		// The method is destructive and the self is mutated.
+		//
+		// ```ruby
+		// a = [1, 2, 3, 4]
+		// a.rotate!      #=> [2, 3, 4, 1]
+		// a.rotate!(-2)  #=> [4, 1, 2, 3]
+		// a              #=> [4, 1, 2, 3]
+		// ```
+		//
+		// @param index [Integer]
+		// @return [Array]
+		Name: "rotate!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			arr := receiver.(*ArrayObject)
+			result := arr.rotate(t, args, sourceLine)
+			rotArr, ok := result.(*ArrayObject)
+
+			if !ok {
+				return result
+			}
+
+			arr.Elements = rotArr.Elements
+			return arr
+
+		},
+	},
+	{
+		// Returns a random element from the array, or `nil` if the array is empty.
+		// If a count `n` is given, returns a new array of `n` elements randomly chosen from different positions.
+		// If `n` is bigger than the size of the array, all elements are returned in random order.
+		//
+		// ```ruby
+		// a = [1, 2, 3, 4]
+		// a.sample    #=> 3
+		// a.sample(2) #=> [4, 1]
+		// a.sample(9) #=> [2, 4, 3, 1]
+		// [].sample   #=> nil
+		// ```
+		//
+		// @param count [Integer]
+		// @return [Object]
+		Name: "sample",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			aLen := len(args)
 			if aLen > 1 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
 			}
 
-			var rotate int
 			arr := receiver.(*ArrayObject)
-			rotArr := t.vm.InitArrayObject(arr.Elements)
 
 			if aLen == 0 {
-				rotate = 1
-			} else {
-				arg, ok := args[0].(*IntegerObject)
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+				if arr.Len() == 0 {
+					return NULL
 				}
-				rotate = arg.value
+				return arr.Elements[t.vm.randomIntn(arr.Len())]
 			}
 
-			if rotate < 0 {
-				for i := 0; i > rotate; i-- {
-					el := rotArr.pop()
-					rotArr.unshift([]Object{el})
-				}
-			} else {
-				for i := 0; i < rotate; i++ {
-					el := rotArr.shift()
-					rotArr.push([]Object{el})
-				}
+			count, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			if count.value < 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.NegativeValue, count.value)
+			}
+
+			n := count.value
+			if n > arr.Len() {
+				n = arr.Len()
 			}
 
-			return rotArr
+			elements := make([]Object, n)
+			for i, index := range t.vm.randomSample(arr.Len(), n) {
+				elements[i] = arr.Elements[index]
+			}
+
+			return t.vm.InitArrayObject(elements)
 
 		},
 	},
@@ -1356,6 +2494,10 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// @return [Object]
 		Name: "shift",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 0 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
@@ -1366,14 +2508,111 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Return a sorted array
+		// Returns a new array with the elements of self in random order.
+		//
+		// ```ruby
+		// a = [1, 2, 3, 4]
+		// a.shuffle #=> [3, 1, 4, 2]
+		// a         #=> [1, 2, 3, 4]
+		// ```
+		//
+		// @return [Array]
+		Name: "shuffle",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			elements := make([]Object, len(arr.Elements))
+			copy(elements, arr.Elements)
+
+			newArr := t.vm.InitArrayObject(elements)
+			newArr.shuffle(t)
+			return newArr
+
+		},
+	},
+	{
+		// A destructive method.
+		// Reorders the elements of self randomly, and then returns self.
+		//
+		// ```ruby
+		// a = [1, 2, 3, 4]
+		// a.shuffle! #=> [3, 1, 4, 2]
+		// a          #=> [3, 1, 4, 2]
+		// ```
+		//
+		// @return [Array]
+		Name: "shuffle!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			arr.shuffle(t)
+			return arr
+
+		},
+	},
+	{
+		// Returns the element of the given index, or a new array of the elements specified by
+		// the start index and the count, or by a Range object.
+		// Negative indices count from the end of the array.
+		// Returns an empty array if the start index equals to the length of the array,
+		// and returns `nil` if the start index is out of range.
+		//
+		// ```ruby
+		// a = [1, 2, 3, 4, 5]
+		// a.slice(1)      #=> 2
+		// a.slice(1, 2)   #=> [2, 3]
+		// a.slice(-2, 5)  #=> [4, 5]
+		// a.slice(5, 1)   #=> []
+		// a.slice(6, 1)   #=> nil
+		// a.slice(1..3)   #=> [2, 3, 4]
+		// a.slice(1..-2)  #=> [2, 3, 4]
+		// a.slice(3..1)   #=> []
+		// a.slice(-7..1)  #=> nil
+		// ```
+		//
+		// @param index [Integer], (count [Integer])
+		// @param range [Range]
+		// @return [Object]
+		Name: "slice",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arr := receiver.(*ArrayObject)
+			return arr.slice(t, args, sourceLine)
+
+		},
+	},
+	{
+		// Returns a new sorted array. The receiver is not mutated.
+		// Without a block, the elements should be all Numeric or all String objects;
+		// comparing incomparable elements returns an ArgumentError.
+		//
+		// If a block is given, the block receives two elements and should return
+		// a negative Integer, `0`, or a positive Integer (usually by `<=>`).
+		// The sort is stable.
 		//
 		// ```ruby
 		// a = [3, 2, 1]
 		// a.sort #=> [1, 2, 3]
+		//
+		// a.sort do |x, y|
+		//   y <=> x
+		// end
+		// #=> [3, 2, 1]
+		//
+		// [1, "a"].sort #=> ArgumentError: Comparison of String with Integer failed
 		// ```
 		//
-		// @return [Object]
+		// @param block literal
+		// @return [Array]
 		Name: "sort",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			if len(args) != 0 {
@@ -1382,11 +2621,208 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 
 			arr := receiver.(*ArrayObject)
 			newArr := arr.copy().(*ArrayObject)
-			sort.Sort(newArr)
+			var err *Error
+
+			if blockFrame == nil {
+				sort.SliceStable(newArr.Elements, func(i, j int) bool {
+					if err != nil {
+						return false
+					}
+
+					left, right := newArr.Elements[i], newArr.Elements[j]
+					result, ok := compareObjects(left, right)
+					if !ok {
+						// The sort may compare a later element with an earlier one, so report them in the array's order
+						if i > j {
+							left, right = right, left
+						}
+						err = t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.ComparisonFailed, left.Class().Name, right.Class().Name)
+					}
+					return result < 0
+				})
+			} else {
+				// If it's an empty array, pop the block's call frame
+				if len(arr.Elements) == 0 {
+					t.callFrameStack.pop()
+				}
+
+				sort.SliceStable(newArr.Elements, func(i, j int) bool {
+					if err != nil {
+						return false
+					}
+
+					switch result := t.builtinMethodYield(blockFrame, newArr.Elements[i], newArr.Elements[j]).Target.(type) {
+					case *IntegerObject:
+						return result.value < 0
+					case *Error:
+						err = result
+					default:
+						err = t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongBlockReturnType, classes.IntegerClass, result.Class().Name)
+					}
+					return false
+				})
+			}
+
+			if err != nil {
+				return err
+			}
+
 			return newArr
 
 		},
 	},
+	{
+		// Returns a new array sorted by the keys computed from the given block. The receiver is not mutated.
+		// The block is evaluated once per element, and the keys should be all Numeric or all String objects;
+		// comparing incomparable keys returns an ArgumentError.
+		// The sort is stable, so the elements with equal keys keep their relative order.
+		// A block literal is required.
+		//
+		// ```ruby
+		// ["ccc", "a", "bb"].sort_by do |s|
+		//   s.length
+		// end
+		// #=> ["a", "bb", "ccc"]
+		//
+		// [3, 1, 2].sort_by do |i|
+		//   -i
+		// end
+		// #=> [3, 2, 1]
+		// ```
+		//
+		// @param block literal
+		// @return [Array]
+		Name: "sort_by",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			arr := receiver.(*ArrayObject)
+			// If it's an empty array, pop the block's call frame
+			if len(arr.Elements) == 0 {
+				t.callFrameStack.pop()
+			}
+
+			indices := make([]int, len(arr.Elements))
+			keys := make([]Object, len(arr.Elements))
+			for i, obj := range arr.Elements {
+				indices[i] = i
+				keys[i] = t.builtinMethodYield(blockFrame, obj).Target
+			}
+
+			var err *Error
+			sort.SliceStable(indices, func(i, j int) bool {
+				if err != nil {
+					return false
+				}
+
+				left, right := keys[indices[i]], keys[indices[j]]
+				result, ok := compareObjects(left, right)
+				if !ok {
+					// The sort may compare a later key with an earlier one, so report them in the array's order
+					if indices[i] > indices[j] {
+						left, right = right, left
+					}
+					err = t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.ComparisonFailed, left.Class().Name, right.Class().Name)
+				}
+				return result < 0
+			})
+
+			if err != nil {
+				return err
+			}
+
+			elements := make([]Object, len(indices))
+			for i, index := range indices {
+				elements[i] = arr.Elements[index]
+			}
+
+			return t.vm.InitArrayObject(elements)
+
+		},
+	},
+	{
+		// Returns a new array that contains the leading elements while the given block returns truthy value.
+		// The iteration stops at the first element that the block returns falsy value.
+		// The receiver is not mutated. A block literal is required.
+		//
+		// ```ruby
+		// [1, 2, 3, 1].take_while do |x|
+		//   x < 3
+		// end
+		// #=> [1, 2]
+		// ```
+		//
+		// @param block literal
+		// @return [Array]
+		Name: "take_while",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			arr := receiver.(*ArrayObject)
+			// If it's an empty array, pop the block's call frame
+			if len(arr.Elements) == 0 {
+				t.callFrameStack.pop()
+			}
+
+			n := arr.countWhile(t, blockFrame)
+			elements := make([]Object, n)
+			copy(elements, arr.Elements[:n])
+
+			return t.vm.InitArrayObject(elements)
+
+		},
+	},
+	{
+		// Returns a hash that maps each distinct element to the number of its occurrences in self.
+		// The keys are in the order of the elements' first occurrences.
+		// Since the keys of a hash are strings, elements with the same string representation,
+		// such as `1` and `"1"`, are counted under the same key.
+		//
+		// ```ruby
+		// ["a", "b", "a", "c", "a"].tally #=> { a: 3, b: 1, c: 1 }
+		// [2, 1, 2].tally                 #=> { 2: 2, 1: 1 }
+		// [].tally                        #=> {}
+		// ```
+		//
+		// @return [Hash]
+		Name: "tally",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			arr := receiver.(*ArrayObject)
+			counts := make(map[string]int)
+			var keys []string
+			for _, obj := range arr.Elements {
+				key := obj.ToString()
+				if _, ok := counts[key]; !ok {
+					keys = append(keys, key)
+				}
+				counts[key]++
+			}
+
+			hash := t.vm.InitHashObject(make(map[string]Object))
+			for _, key := range keys {
+				hash.set(key, t.vm.InitIntegerObject(counts[key]))
+			}
+
+			return hash
+
+		},
+	},
 	{
 		// Returns the result of interpreting ary as an array of [key value] array pairs.
 		// Note that the keys should always be String or symbol literals (using symbol literal is preferable).
@@ -1447,6 +2883,10 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// @return [Array]
 		Name: "unshift",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			arr := receiver.(*ArrayObject)
 			return arr.unshift(args)
 
@@ -1462,6 +2902,7 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 		// a.values_at(1)     #=> ["b"]
 		// a.values_at(-1, 3) #=> ["c", nil]
 		// a.values_at()      #=> []
+		// [1, 2, 3, 4].values_at(0, 2, -1) #=> [1, 3, 4]
 		// ```
 		//
 		// @param index [Integer]...
@@ -1475,18 +2916,58 @@ var builtinArrayInstanceMethods = []*BuiltinMethodObject{
 				index, ok := arg.(*IntegerObject)
 
 				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, arg.Class().Name)
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, arg.Class().Name)
+				}
+
+				normalizedIndex := arr.normalizeIndex(index)
+				if normalizedIndex == -1 {
+					elements[i] = NULL
+				} else {
+					elements[i] = arr.Elements[normalizedIndex]
+				}
+			}
+
+			return t.vm.InitArrayObject(elements)
+
+		},
+	},
+	{
+		// Returns a new array of arrays by pairing up the elements of self and the given arrays with the same index.
+		// The length of the result is the same as self. The missing elements of the shorter arrays are filled with `nil`,
+		// and the extra elements of the longer arrays are dropped.
+		//
+		// ```ruby
+		// [1, 2].zip([3, 4], [5, 6])  #=> [[1, 3, 5], [2, 4, 6]]
+		// [1, 2, 3].zip([4])          #=> [[1, 4], [2, nil], [3, nil]]
+		// [1].zip([2, 3])             #=> [[1, 2]]
+		// [1, 2].zip                  #=> [[1], [2]]
+		// ```
+		//
+		// @param array [Array]...
+		// @return [Array]
+		Name: "zip",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			arrays := make([]*ArrayObject, len(args))
+			for i, arg := range args {
+				a, ok := arg.(*ArrayObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.ArrayClass, arg.Class().Name)
 				}
+				arrays[i] = a
+			}
 
-				if index.value >= len(arr.Elements) {
-					elements[i] = NULL
-				} else if index.value < 0 && -index.value > len(arr.Elements) {
-					elements[i] = NULL
-				} else if index.value < 0 {
-					elements[i] = arr.Elements[len(arr.Elements)+index.value]
-				} else {
-					elements[i] = arr.Elements[index.value]
+			arr := receiver.(*ArrayObject)
+			elements := make([]Object, len(arr.Elements))
+			for i, obj := range arr.Elements {
+				tuple := []Object{obj}
+				for _, a := range arrays {
+					if i < len(a.Elements) {
+						tuple = append(tuple, a.Elements[i])
+					} else {
+						tuple = append(tuple, NULL)
+					}
 				}
+				elements[i] = t.vm.InitArrayObject(tuple)
 			}
 
 			return t.vm.InitArrayObject(elements)
@@ -1560,6 +3041,139 @@ func (a *ArrayObject) ToJSON(t *Thread) string {
 	return out.String()
 }
 
+// eachWindow returns the slices of `n` elements of the array, or the consecutive windows of `n` elements
+// if overlapping is true; common to `each_cons` and `each_slice`. The slices are yielded to the block instead if given.
+func (a *ArrayObject) eachWindow(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int, overlapping bool) Object {
+	if len(args) != 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+	}
+
+	n, ok := args[0].(*IntegerObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+	}
+
+	if n.value <= 0 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.NegativeValue, n.value)
+	}
+
+	step := n.value
+	if overlapping {
+		step = 1
+	}
+
+	windows := []Object{}
+	for start := 0; start < len(a.Elements); start += step {
+		end := start + n.value
+		if end > len(a.Elements) {
+			if overlapping {
+				break
+			}
+			end = len(a.Elements)
+		}
+
+		elements := make([]Object, end-start)
+		copy(elements, a.Elements[start:end])
+		windows = append(windows, t.vm.InitArrayObject(elements))
+	}
+
+	if blockFrame == nil {
+		return t.vm.InitArrayObject(windows)
+	}
+
+	if blockIsEmpty(blockFrame) {
+		return a
+	}
+
+	// If there's nothing to yield, pop the block's call frame
+	if len(windows) == 0 {
+		t.callFrameStack.pop()
+	}
+
+	for _, window := range windows {
+		t.builtinMethodYield(blockFrame, window)
+	}
+
+	return a
+}
+
+// tuples returns the combinations, or the permutations if ordered is true, of `n` elements of the array;
+// common to `combination` and `permutation`. The tuples are yielded to the block instead if given.
+func (a *ArrayObject) tuples(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int, ordered bool) Object {
+	if len(args) != 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+	}
+
+	n, ok := args[0].(*IntegerObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+	}
+
+	if n.value < 0 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.NegativeValue, n.value)
+	}
+
+	var indices [][]int
+	used := make([]bool, len(a.Elements))
+	current := make([]int, 0, n.value)
+
+	var collect func(start int)
+	collect = func(start int) {
+		if len(current) == n.value {
+			indices = append(indices, append([]int{}, current...))
+			return
+		}
+
+		for i := start; i < len(a.Elements); i++ {
+			if used[i] {
+				continue
+			}
+
+			used[i] = true
+			current = append(current, i)
+			if ordered {
+				collect(0)
+			} else {
+				collect(i + 1)
+			}
+			current = current[:len(current)-1]
+			used[i] = false
+		}
+	}
+
+	if n.value <= len(a.Elements) {
+		collect(0)
+	}
+
+	elements := make([]Object, len(indices))
+	for i, tupleIndices := range indices {
+		tuple := make([]Object, len(tupleIndices))
+		for j, index := range tupleIndices {
+			tuple[j] = a.Elements[index]
+		}
+		elements[i] = t.vm.InitArrayObject(tuple)
+	}
+
+	if blockFrame == nil {
+		return t.vm.InitArrayObject(elements)
+	}
+
+	if blockIsEmpty(blockFrame) {
+		return a
+	}
+
+	// If there's nothing to yield, pop the block's call frame
+	if len(elements) == 0 {
+		t.callFrameStack.pop()
+	}
+
+	for _, tuple := range elements {
+		t.builtinMethodYield(blockFrame, tuple)
+	}
+
+	return a
+}
+
 // concatenateCopies returns a array composed of N copies of the array
 func (a *ArrayObject) concatenateCopies(t *Thread, n *IntegerObject) Object {
 	aLen := len(a.Elements)
@@ -1572,6 +3186,109 @@ func (a *ArrayObject) concatenateCopies(t *Thread, n *IntegerObject) Object {
 	return t.vm.InitArrayObject(result)
 }
 
+// anyMatch returns true if the truthiness of any element equals to the expected value; common to `all?`, `any?` and `none?`.
+// The element is evaluated by the block if given. The iteration stops at the first matched element.
+func (a *ArrayObject) anyMatch(t *Thread, blockFrame *normalCallFrame, expected bool) bool {
+	if blockFrame == nil {
+		for _, obj := range a.Elements {
+			if obj.isTruthy() == expected {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// If it's an empty array, pop the block's call frame
+	if len(a.Elements) == 0 {
+		t.callFrameStack.pop()
+		return false
+	}
+
+	// An empty block always returns `nil`
+	if blockIsEmpty(blockFrame) {
+		return !expected
+	}
+
+	for _, obj := range a.Elements {
+		result := t.builtinMethodYield(blockFrame, obj)
+		if result.Target.isTruthy() == expected {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compact returns a new slice of Elements without `nil`
+func (a *ArrayObject) compact() []Object {
+	elements := []Object{}
+
+	for _, e := range a.Elements {
+		if _, isNull := e.(*NullObject); !isNull {
+			elements = append(elements, e)
+		}
+	}
+
+	return elements
+}
+
+// compare sends `<=>` to each element with the other array's element at the same index, so user-defined `<=>` is used.
+// Returns nil if an element doesn't respond to `<=>` or its `<=>` doesn't return an Integer; common to `<=>`.
+func (a *ArrayObject) compare(t *Thread, other *ArrayObject, sourceLine int) Object {
+	for i := 0; i < len(a.Elements) && i < len(other.Elements); i++ {
+		// Identical objects like `nil` are equal even if they're not comparable
+		if a.Elements[i] == other.Elements[i] {
+			continue
+		}
+
+		if a.Elements[i].findMethod("<=>") == nil {
+			return NULL
+		}
+
+		result := t.callMethod(a.Elements[i], "<=>", sourceLine, other.Elements[i])
+
+		switch r := result.(type) {
+		case *Error:
+			return r
+		case *IntegerObject:
+			switch {
+			case r.value < 0:
+				return t.vm.InitIntegerObject(-1)
+			case r.value > 0:
+				return t.vm.InitIntegerObject(1)
+			}
+		default:
+			return NULL
+		}
+	}
+
+	switch {
+	case len(a.Elements) < len(other.Elements):
+		return t.vm.InitIntegerObject(-1)
+	case len(a.Elements) > len(other.Elements):
+		return t.vm.InitIntegerObject(1)
+	default:
+		return t.vm.InitIntegerObject(0)
+	}
+}
+
+// countWhile returns the count of the leading elements that the block returns truthy value; common to `take_while` and `drop_while`.
+func (a *ArrayObject) countWhile(t *Thread, blockFrame *normalCallFrame) int {
+	if blockIsEmpty(blockFrame) {
+		return 0
+	}
+
+	for i, obj := range a.Elements {
+		result := t.builtinMethodYield(blockFrame, obj)
+		if !result.Target.isTruthy() {
+			return i
+		}
+	}
+
+	return len(a.Elements)
+}
+
 // recursive indexed access - see ArrayObject#dig documentation.
 func (a *ArrayObject) dig(t *Thread, keys []Object, sourceLine int) Object {
 	currentKey := keys[0]
@@ -1590,7 +3307,7 @@ func (a *ArrayObject) dig(t *Thread, keys []Object, sourceLine int) Object {
 	nextKeys := keys[1:]
 	currentValue := a.Elements[normalizedIndex]
 
-	if len(nextKeys) == 0 {
+	if len(nextKeys) == 0 || currentValue == NULL {
 		return currentValue
 	}
 
@@ -1603,6 +3320,81 @@ func (a *ArrayObject) dig(t *Thread, keys []Object, sourceLine int) Object {
 	return diggableCurrentValue.dig(t, nextKeys, sourceLine)
 }
 
+// Retrieves objects in an array using Integer index or Range; common to `[]` and `slice()`.
+func (a *ArrayObject) slice(t *Thread, args []Object, sourceLine int) Object {
+	if len(args) == 1 {
+		if r, ok := args[0].(*RangeObject); ok {
+			return a.sliceByRange(t, r)
+		}
+	}
+
+	return a.index(t, args, sourceLine)
+}
+
+// rotate returns a new array rotated to left by the given count, or to right if the count is negative;
+// common to `rotate` and `rotate!`
+func (a *ArrayObject) rotate(t *Thread, args []Object, sourceLine int) Object {
+	aLen := len(args)
+	if aLen > 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
+	}
+
+	rotate := 1
+
+	if aLen == 1 {
+		arg, ok := args[0].(*IntegerObject)
+		if !ok {
+			return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+		}
+		rotate = arg.value
+	}
+
+	elements := make([]Object, 0, len(a.Elements))
+
+	if len(a.Elements) > 0 {
+		rotate %= len(a.Elements)
+		if rotate < 0 {
+			rotate += len(a.Elements)
+		}
+
+		elements = append(elements, a.Elements[rotate:]...)
+		elements = append(elements, a.Elements[:rotate]...)
+	}
+
+	return t.vm.InitArrayObject(elements)
+}
+
+// sliceByRange returns a new array of the elements within the given range, or `nil` if the range starts out of bounds.
+func (a *ArrayObject) sliceByRange(t *Thread, r *RangeObject) Object {
+	arrLength := a.Len()
+	start, end := r.Start, r.End
+
+	if start < 0 {
+		start = arrLength + start
+	}
+	if end < 0 {
+		end = arrLength + end
+	}
+	if r.Exclusive {
+		end--
+	}
+
+	if start < 0 || start > arrLength {
+		return NULL
+	}
+
+	if end >= arrLength {
+		end = arrLength - 1
+	}
+
+	elements := []Object{}
+	if start <= end {
+		elements = append(elements, a.Elements[start:end+1]...)
+	}
+
+	return t.vm.InitArrayObject(elements)
+}
+
 // Retrieves an object in an array using Integer index; common to `[]` and `at()`.
 func (a *ArrayObject) index(t *Thread, args []Object, sourceLine int) Object {
 	aLen := len(args)
@@ -1664,14 +3456,108 @@ func (a *ArrayObject) index(t *Thread, args []Object, sourceLine int) Object {
 	return a.Elements[normalizedIndex]
 }
 
+// Returns the first element that the block returns truthy value; common to `find` and `detect`.
+func (a *ArrayObject) find(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int) Object {
+	if len(args) != 0 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+	}
+
+	if blockFrame == nil {
+		return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+	}
+
+	// If it's an empty array, pop the block's call frame
+	if len(a.Elements) == 0 {
+		t.callFrameStack.pop()
+	}
+
+	if blockIsEmpty(blockFrame) {
+		return NULL
+	}
+
+	for _, obj := range a.Elements {
+		result := t.builtinMethodYield(blockFrame, obj)
+		if result.Target.isTruthy() {
+			return obj
+		}
+	}
+
+	return NULL
+}
+
+// Returns the index of the first element that matches the argument or the block; common to `index` and `find_index`.
+func (a *ArrayObject) findIndex(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int) Object {
+	aLen := len(args)
+	if aLen > 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
+	}
+
+	if blockFrame != nil {
+		// If it's an empty array, pop the block's call frame
+		if len(a.Elements) == 0 {
+			t.callFrameStack.pop()
+		}
+
+		if blockIsEmpty(blockFrame) {
+			return NULL
+		}
+
+		for i, obj := range a.Elements {
+			result := t.builtinMethodYield(blockFrame, obj)
+			if result.Target.isTruthy() {
+				return t.vm.InitIntegerObject(i)
+			}
+		}
+
+		return NULL
+	}
+
+	if aLen == 0 {
+		return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+	}
+
+	for i, obj := range a.Elements {
+		if t.isEqual(obj, args[0], sourceLine) {
+			return t.vm.InitIntegerObject(i)
+		}
+	}
+
+	return NULL
+}
+
+// flattenByArgs returns a new array flattened to the depth given as the optional argument; common to `flatten` and `flatten!`
+func (a *ArrayObject) flattenByArgs(t *Thread, args []Object, sourceLine int) Object {
+	aLen := len(args)
+	if aLen > 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
+	}
+
+	depth := -1
+	if aLen == 1 {
+		d, ok := args[0].(*IntegerObject)
+		if !ok {
+			return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+		}
+		depth = d.value
+	}
+
+	return t.vm.InitArrayObject(a.flattenWithDepth(depth))
+}
+
 // flatten returns a array of Objects that is one-dimensional flattening of Elements
 func (a *ArrayObject) flatten() []Object {
-	var result []Object
+	return a.flattenWithDepth(-1)
+}
+
+// flattenWithDepth flattens the nested arrays of Elements up to the given depth.
+// A negative depth means no limit.
+func (a *ArrayObject) flattenWithDepth(depth int) []Object {
+	result := []Object{}
 
 	for _, e := range a.Elements {
 		arr, isArray := e.(*ArrayObject)
-		if isArray {
-			result = append(result, arr.flatten()...)
+		if isArray && depth != 0 {
+			result = append(result, arr.flattenWithDepth(depth-1)...)
 		} else {
 			result = append(result, e)
 		}
@@ -1680,6 +3566,17 @@ func (a *ArrayObject) flatten() []Object {
 	return result
 }
 
+// includes returns true if any element is equal to the given object with `==`
+func (a *ArrayObject) includes(t *Thread, obj Object, sourceLine int) bool {
+	for _, elem := range a.Elements {
+		if t.isEqual(elem, obj, sourceLine) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Len returns the length of array's elements
 func (a *ArrayObject) Len() int {
 	return len(a.Elements)
@@ -1709,6 +3606,100 @@ func (a *ArrayObject) Less(i, j int) bool {
 	}
 }
 
+// compareObjects compares two objects with the VM's comparison rules and returns -1, 0 or 1.
+// Only Numeric objects, String objects or Array objects are comparable to each other, and Arrays
+// are compared element by element; ok is false otherwise.
+func compareObjects(left, right Object) (result int, ok bool) {
+	switch l := left.(type) {
+	case Numeric:
+		if _, ok := right.(Numeric); !ok {
+			return 0, false
+		}
+
+		switch {
+		case l.lessThan(right):
+			return -1, true
+		case right.(Numeric).lessThan(left):
+			return 1, true
+		default:
+			return 0, true
+		}
+	case *StringObject:
+		r, ok := right.(*StringObject)
+		if !ok {
+			return 0, false
+		}
+
+		return strings.Compare(l.value, r.value), true
+	case *ArrayObject:
+		r, ok := right.(*ArrayObject)
+		if !ok {
+			return 0, false
+		}
+
+		for i := 0; i < len(l.Elements) && i < len(r.Elements); i++ {
+			// Identical objects like `nil` are equal even if they're not comparable
+			if l.Elements[i] == r.Elements[i] {
+				continue
+			}
+
+			result, ok := compareObjects(l.Elements[i], r.Elements[i])
+			if !ok || result != 0 {
+				return result, ok
+			}
+		}
+
+		switch {
+		case len(l.Elements) < len(r.Elements):
+			return -1, true
+		case len(l.Elements) > len(r.Elements):
+			return 1, true
+		default:
+			return 0, true
+		}
+	default:
+		return 0, false
+	}
+}
+
+// extremeBy returns the element with the largest key computed by the block if sign is 1,
+// or the smallest one if sign is -1; common to `max_by` and `min_by`.
+func (a *ArrayObject) extremeBy(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int, sign int) Object {
+	if len(args) != 0 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+	}
+
+	if blockFrame == nil {
+		return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+	}
+
+	// If it's an empty array, pop the block's call frame
+	if len(a.Elements) == 0 {
+		t.callFrameStack.pop()
+		return NULL
+	}
+
+	var result, resultKey Object
+	for _, obj := range a.Elements {
+		key := t.builtinMethodYield(blockFrame, obj).Target
+		if resultKey == nil {
+			result, resultKey = obj, key
+			continue
+		}
+
+		c, ok := compareObjects(key, resultKey)
+		if !ok {
+			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.ComparisonFailed, resultKey.Class().Name, key.Class().Name)
+		}
+
+		if c*sign > 0 {
+			result, resultKey = obj, key
+		}
+	}
+
+	return result
+}
+
 // normalizes the index to the Ruby-style:
 //
 // 1. if the index is between o and the index length, returns the index
@@ -1754,6 +3745,47 @@ func (a *ArrayObject) push(objs []Object) *ArrayObject {
 	return a
 }
 
+// Accumulates the elements with the given block; common to `reduce` and `inject`.
+func (a *ArrayObject) reduce(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int) Object {
+	aLen := len(args)
+	if aLen > 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
+	}
+	if blockFrame == nil {
+		return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+	}
+
+	// If it's an empty array, pop the block's call frame
+	if len(a.Elements) == 0 {
+		t.callFrameStack.pop()
+	}
+
+	if blockIsEmpty(blockFrame) {
+		return NULL
+	}
+
+	var prev Object
+	var start int
+	switch aLen {
+	case 0:
+		if len(a.Elements) == 0 {
+			return NULL
+		}
+		prev = a.Elements[0]
+		start = 1
+	case 1:
+		prev = args[0]
+		start = 0
+	}
+
+	for i := start; i < len(a.Elements); i++ {
+		result := t.builtinMethodYield(blockFrame, prev, a.Elements[i])
+		prev = result.Target
+	}
+
+	return prev
+}
+
 // returns a reversed copy of the passed array
 func (a *ArrayObject) reverse() *ArrayObject {
 	arrLen := len(a.Elements)
@@ -1782,6 +3814,11 @@ func (a *ArrayObject) shift() Object {
 	return value
 }
 
+// shuffle reorders the elements of the array randomly with the VM's random source
+func (a *ArrayObject) shuffle(t *Thread) {
+	t.vm.randomShuffle(a.Len(), a.Swap)
+}
+
 // copy returns the duplicate of the Array object
 func (a *ArrayObject) copy() Object {
 	e := make([]Object, len(a.Elements))
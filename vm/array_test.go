@@ -72,6 +72,35 @@ func TestArrayComparison(t *testing.T) {
 		{`[1, { a: 1, b: 2 }, "Goby" ] != [1, { a: 1, b: 2, c: 3 }, "Goby"]`, true},  // Array of hash has no order issue
 		{`[1, { a: 1, b: 2 }, "Goby" ] != [1, { a: 2, b: 2, a: 1 }, "Goby"]`, false}, // Array of hash key will be overwritten if duplicated
 		{`[1, "String", true, 2..5] != Integer`, true},
+		{`[1, 2] <=> [1, 3]`, -1},
+		{`[1, 2] <=> [1, 2]`, 0},
+		{`[1, 3] <=> [1, 2]`, 1},
+		{`[1, 2] <=> [1, 2, 3]`, -1},
+		{`[1, 2, 3] <=> [1, 2]`, 1},
+		{`[] <=> []`, 0},
+		{`["a", "b"] <=> ["a", "c"]`, -1},
+		{`[1, 2.5] <=> [1, 2]`, 1},
+		{`[[1, 2], 3] <=> [[1], 4]`, 1},
+		{`[nil, 1] <=> [nil, 2]`, -1},
+		{`[2, "a"] <=> [1, 2]`, 1},
+		{`[1, "a"] <=> [1, 2]`, nil},
+		{`[1, 2] <=> 1`, nil},
+		{`[nil, 1] <=> [false, 2]`, nil},
+		{`
+		class Version
+		  attr_reader :number
+
+		  def initialize(number)
+		    @number = number
+		  end
+
+		  def <=>(other)
+		    @number <=> other.number
+		  end
+		end
+
+		[[Version.new(1), 5] <=> [Version.new(2), 1], [Version.new(2)] <=> [Version.new(2)]]
+		`, []interface{}{-1, 0}},
 	}
 
 	for i, tt := range tests {
@@ -437,6 +466,71 @@ func TestArrayIndexWithSuccessiveValuesFail(t *testing.T) {
 	}
 }
 
+func TestArrayAllMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+			[1, 2, 3].all? do |e|
+			  e > 0
+			end
+		`, true},
+		{`
+			[1, 2, 3].all? do |e|
+			  e > 1
+			end
+		`, false},
+		{`
+			[].all? do |e|
+			  false
+			end
+		`, true},
+		{`[1, "a", true].all?`, true},
+		{`[1, nil, true].all?`, false},
+		{`[1, false].all?`, false},
+		{`[].all?`, true},
+		// cases for providing an empty block
+		{`
+			[1, 2, 3].all? do end
+		`, false},
+		{`
+			[].all? do |i| end
+		`, true},
+		// short-circuits on the first failure
+		{`
+			count = 0
+			[1, 2, 3].all? do |e|
+			  count += 1
+			  e > 1
+			end
+			count
+		`, 1},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayAllMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[].all?(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayAnyMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -480,6 +574,20 @@ func TestArrayAnyMethod(t *testing.T) {
 		{`
 			[].any? do |i| end
 		`, false},
+		// cases without a block
+		{`[1, 2, 3].any?`, true},
+		{`[nil, false, 1].any?`, true},
+		{`[nil, false].any?`, false},
+		{`[].any?`, false},
+		// short-circuits on the first success
+		{`
+			count = 0
+			[1, 2, 3].any? do |e|
+			  count += 1
+			  e == 1
+			end
+			count
+		`, 1},
 	}
 
 	for i, tt := range tests {
@@ -493,7 +601,7 @@ func TestArrayAnyMethod(t *testing.T) {
 
 func TestArrayAnyMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
-		{`[].any?`, "InternalError: Can't yield without a block", 1},
+		{`[].any?(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -568,6 +676,74 @@ func TestArrayAtMethodFail(t *testing.T) {
 	}
 }
 
+func TestArrayChunkWhileMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		[1, 2, 4, 5, 7].chunk_while do |a, b|
+		  b - a == 1
+		end.to_s
+		`, "[[1, 2], [4, 5], [7]]"},
+		{`
+		[1, 2, 3].chunk_while do |a, b|
+		  true
+		end.to_s
+		`, "[[1, 2, 3]]"},
+		{`
+		[1, 2, 3].chunk_while do |a, b|
+		  nil
+		end.to_s
+		`, "[[1], [2], [3]]"},
+		{`
+		[1, 2, 3].chunk_while do |a, b|
+		end.to_s
+		`, "[[1], [2], [3]]"},
+		{`
+		[1].chunk_while do |a, b|
+		  false
+		end.to_s
+		`, "[[1]]"},
+		{`
+		[].chunk_while do |a, b|
+		  true
+		end.to_s
+		`, "[]"},
+		{`
+		["a", "a", "b", "c", "c"].chunk_while do |a, b|
+		  a == b
+		end.to_s
+		`, `[["a", "a"], ["b"], ["c", "c"]]`},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayChunkWhileMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].chunk_while`, "InternalError: Can't yield without a block", 1},
+		{`[1, 2].chunk_while(1) do |a, b|
+		  true
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayClearMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -607,6 +783,149 @@ func TestArrayClearMethodFail(t *testing.T) {
 	}
 }
 
+func TestArrayCombinationMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2, 3].combination(2).to_s`, "[[1, 2], [1, 3], [2, 3]]"},
+		{`[1, 2, 3].combination(1).to_s`, "[[1], [2], [3]]"},
+		{`[1, 2, 3].combination(3).to_s`, "[[1, 2, 3]]"},
+		{`[1, 2, 3].combination(0).to_s`, "[[]]"},
+		{`[1, 2, 3].combination(4).to_s`, "[]"},
+		{`[1, 1].combination(2).to_s`, "[[1, 1]]"},
+		{`[1, 2, 3, 4, 5].combination(3).length`, 10},
+		{`
+		sum = 0
+		[1, 2, 3].combination(2) do |c|
+		  sum += c[0] * c[1]
+		end
+		sum
+		`, 11},
+		{`
+		a = [1, 2, 3]
+		a.combination(2) do |c|
+		end.object_id == a.object_id
+		`, true},
+		{`
+		a = [1, 2, 3]
+		a.combination(4) do |c|
+		  puts(c)
+		end.to_s
+		`, "[1, 2, 3]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayCombinationMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].combination`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`[1, 2].combination(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`[1, 2].combination("a")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, 2].combination(-1)`, "ArgumentError: Expect argument to be positive value. got: -1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayCompactMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []interface{}
+	}{
+		{`
+		code = []
+		code[100] = 'Continue'
+		code[101] = 'Switching Protocols'
+		code[102] = 'Processing'
+		code[200] = 'OK'
+		code.compact
+		`, []interface{}{"Continue", "Switching Protocols", "Processing", "OK"}},
+		{`
+		a = [1, nil, "a", nil, false]
+		a.compact
+		`, []interface{}{1, "a", false}},
+		{`
+		a = [1, nil, 2]
+		a.compact
+		a
+		`, []interface{}{1, nil, 2}},
+		{`
+		[nil, nil].compact
+		`, []interface{}{}},
+		{`
+		[].compact
+		`, []interface{}{}},
+		{`
+		a = [nil, 1, nil, 2]
+		a.compact!
+		`, []interface{}{1, 2}},
+		{`
+		a = [nil, 1, nil, 2]
+		a.compact!
+		a
+		`, []interface{}{1, 2}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayCompactBangMethodReturnValue(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		a = [1, nil]
+		a.compact!.object_id == a.object_id
+		`, true},
+		{`[1, 2].compact!`, nil},
+		{`[].compact!`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayCompactMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, nil].compact(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`[1, nil].compact!(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayConcatMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -624,6 +943,21 @@ func TestArrayConcatMethod(t *testing.T) {
 		a = [1, 2]
 		a.concat()
 		`, []interface{}{1, 2}},
+		{`
+		a = [1, 2]
+		a.concat([3])
+		a
+		`, []interface{}{1, 2, 3}},
+		{`
+		a = [1, 2]
+		a.concat(a)
+		`, []interface{}{1, 2, 1, 2}},
+		{`
+		a = [1]
+		b = [2]
+		a.concat(b)
+		b
+		`, []interface{}{2}},
 	}
 
 	for i, tt := range tests {
@@ -643,6 +977,9 @@ func TestArrayConcatMethodFail(t *testing.T) {
 		{`a = []
 		a.concat("a")
 		`, "TypeError: Expect argument to be Array. got: String", 1},
+		{`a = [1, 2]
+		a.concat([3], nil)
+		`, "TypeError: Expect argument to be Array. got: Null", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -741,6 +1078,84 @@ func TestArrayCountMethodFail(t *testing.T) {
 	}
 }
 
+func TestArrayDeleteMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		a = [1, 2, 1, 3]
+		a.delete(1)
+		`, 1},
+		{`
+		a = [1, 2, 1, 3]
+		a.delete(1)
+		a.to_s
+		`, "[2, 3]"},
+		{`
+		a = ["a", nil, "b", nil]
+		a.delete(nil)
+		a.to_s
+		`, `["a", "b"]`},
+		{`
+		a = [[1], [2], [1]]
+		a.delete([1])
+		a.to_s
+		`, "[[2]]"},
+		{`
+		a = [1, 2, 3]
+		a.delete(5)
+		`, nil},
+		{`
+		a = [1, 2, 3]
+		a.delete(5)
+		a.to_s
+		`, "[1, 2, 3]"},
+		{`
+		a = [1, 2, 3]
+		a.delete(5) do
+		  "missing"
+		end
+		`, "missing"},
+		{`
+		a = [1, 2, 3]
+		a.delete(5) do |x|
+		  x * 2
+		end
+		`, 10},
+		{`
+		a = [1, 2, 3]
+		a.delete(2) do
+		  "missing"
+		end
+		`, 2},
+		{`[].delete(1)`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayDeleteMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].delete`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`[1, 2].delete(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayDeleteAtMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -799,6 +1214,17 @@ func TestArrayDeleteAtMethod(t *testing.T) {
 			a.delete_at(-5)
 			a
 		`, []interface{}{1, "a", 10, 5}},
+		{`
+			a = [1, 2, 3]
+			a.delete_at(1)
+			a
+		`, []interface{}{1, 3}},
+		{`
+			a = [1, 2, 3]
+			b = a.first(2)
+			a.delete_at(0)
+			b
+		`, []interface{}{1, 2}},
 	}
 
 	for i, tt := range testsArray {
@@ -845,6 +1271,9 @@ func TestArrayDigMethod(t *testing.T) {
 			[[], 2].dig(0, 1, 2)
 		`, nil},
 		{`[[1, 2, [3, [8, [9]]]], 4, 5].dig(0, 2, 1, 1, 0)`, 9},
+		{`[[1, [2, 3]]].dig(0, 1, 0)`, 2},
+		{`[[nil], 2].dig(0, 0, 1)`, nil},
+		{`[{a: nil}].dig(0, :a, 1)`, nil},
 	}
 
 	for i, tt := range tests {
@@ -860,6 +1289,8 @@ func TestArrayDigMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`[1, 2].dig`, "ArgumentError: Expect 1 or more argument(s). got: 0", 1},
 		{`[1, 2].dig(0, 1)`, "TypeError: Expect target to be Diggable, got Integer", 1},
+		{`[[1, 2]].dig(0, "a")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[["a"]].dig(0, 0, 1)`, "TypeError: Expect target to be Diggable, got String", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -871,26 +1302,88 @@ func TestArrayDigMethodFail(t *testing.T) {
 	}
 }
 
-func TestArrayEachMethod(t *testing.T) {
+func TestArrayDropWhileMethod(t *testing.T) {
 	tests := []struct {
 		input    string
-		expected int
+		expected []interface{}
 	}{
 		{`
-		sum = 0
-		[1, 2, 3, 4, 5].each do |i|
-		  sum = sum + i
+		[1, 2, 3, 1].drop_while do |x|
+		  x < 3
 		end
-		sum
-		`, 15},
+		`, []interface{}{3, 1}},
 		{`
-		sum = 0
-		[].each do |i|
-		  sum += i
+		[1, 2, 3].drop_while do |x|
+		  x < 10
 		end
-		sum
-		`, 0},
-		// cases for providing an empty block
+		`, []interface{}{}},
+		{`
+		[1, 2, 3].drop_while do |x|
+		  x > 10
+		end
+		`, []interface{}{1, 2, 3}},
+		{`
+		[].drop_while do |x|
+		  true
+		end
+		`, []interface{}{}},
+		{`
+		a = [1, 2, 3]
+		a.drop_while do |x|
+		  x < 2
+		end
+		a
+		`, []interface{}{1, 2, 3}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayDropWhileMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].drop_while`, "InternalError: Can't yield without a block", 1},
+		{`
+		[1, 2].drop_while(1) do |x|
+		  x
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayEachMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{`
+		sum = 0
+		[1, 2, 3, 4, 5].each do |i|
+		  sum = sum + i
+		end
+		sum
+		`, 15},
+		{`
+		sum = 0
+		[].each do |i|
+		  sum += i
+		end
+		sum
+		`, 0},
+		// cases for providing an empty block
 		{`
 		a = [1,2,3].each do
 		end
@@ -941,6 +1434,63 @@ func TestArrayEachMethodFail(t *testing.T) {
 	}
 }
 
+func TestArrayEachConsMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2, 3, 4].each_cons(2).to_s`, "[[1, 2], [2, 3], [3, 4]]"},
+		{`[1, 2, 3, 4].each_cons(4).to_s`, "[[1, 2, 3, 4]]"},
+		{`[1, 2, 3].each_cons(1).to_s`, "[[1], [2], [3]]"},
+		{`[1, 2, 3].each_cons(4).to_s`, "[]"},
+		{`[].each_cons(2).to_s`, "[]"},
+		{`
+		averages = []
+		[2, 4, 6, 8].each_cons(2) do |w|
+		  averages.push((w[0] + w[1]) / 2)
+		end
+		averages.to_s
+		`, "[3, 5, 7]"},
+		{`
+		a = [1, 2, 3]
+		a.each_cons(2) do |w|
+		end.object_id == a.object_id
+		`, true},
+		{`
+		a = [1, 2, 3]
+		a.each_cons(4) do |w|
+		  puts(w)
+		end.to_s
+		`, "[1, 2, 3]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayEachConsMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].each_cons`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`[1, 2].each_cons(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`[1, 2].each_cons("a")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, 2].each_cons(0)`, "ArgumentError: Expect argument to be positive value. got: 0", 1},
+		{`[1, 2].each_cons(-1)`, "ArgumentError: Expect argument to be positive value. got: -1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayEachIndexMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1011,6 +1561,196 @@ func TestArrayEachIndexMethodFail(t *testing.T) {
 	}
 }
 
+func TestArrayEachSliceMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2, 3, 4, 5].each_slice(2).to_s`, "[[1, 2], [3, 4], [5]]"},
+		{`[1, 2, 3, 4].each_slice(2).to_s`, "[[1, 2], [3, 4]]"},
+		{`[1, 2, 3].each_slice(1).to_s`, "[[1], [2], [3]]"},
+		{`[1, 2, 3].each_slice(5).to_s`, "[[1, 2, 3]]"},
+		{`[].each_slice(2).to_s`, "[]"},
+		{`
+		sums = []
+		[1, 2, 3, 4, 5].each_slice(2) do |s|
+		  sums.push(s.reduce(0) do |sum, i|
+		    sum + i
+		  end)
+		end
+		sums.to_s
+		`, "[3, 7, 5]"},
+		{`
+		a = [1, 2, 3]
+		a.each_slice(2) do |s|
+		end.object_id == a.object_id
+		`, true},
+		{`
+		a = []
+		a.each_slice(2) do |s|
+		  puts(s)
+		end.to_s
+		`, "[]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayEachSliceMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].each_slice`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`[1, 2].each_slice(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`[1, 2].each_slice("a")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, 2].each_slice(0)`, "ArgumentError: Expect argument to be positive value. got: 0", 1},
+		{`[1, 2].each_slice(-1)`, "ArgumentError: Expect argument to be positive value. got: -1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayEachWithIndexMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		sum = 0
+		[2, 3, 40].each_with_index do |e, i|
+		  sum = sum + e * i
+		end
+		sum
+		`, 83},
+		{`
+		s = ""
+		[:a, :b, :c].each_with_index do |e, i|
+		  s = s + e + i.to_s
+		end
+		s
+		`, "a0b1c2"},
+		{`
+		count = 0
+		[].each_with_index do |e, i|
+		  count += 1
+		end
+		count
+		`, 0},
+		{`
+		a = [1, 2, 3]
+		b = a.each_with_index do |e, i|
+		end
+		a.object_id == b.object_id
+		`, true},
+		{`
+		[1, 2, 3].each_with_index do |e, i|
+		  e + i
+		end.length
+		`, 3},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayEachWithIndexMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].each_with_index`, "InternalError: Can't yield without a block", 1},
+		{`
+		[1, 2].each_with_index(1) do |e, i|
+		  puts e
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayEachWithObjectMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		[1, 2, 3].each_with_object([]) do |x, acc|
+		  acc.push(x * 2)
+		end.to_s
+		`, "[2, 4, 6]"},
+		{`
+		h = [:a, :b].each_with_object({}) do |e, memo|
+		  memo[e] = e + e
+		end
+		h["b"]
+		`, "bb"},
+		{`
+		memo = []
+		result = [1, 2].each_with_object(memo) do |x, acc|
+		  acc.push(x)
+		  nil
+		end
+		result.object_id == memo.object_id
+		`, true},
+		{`
+		[].each_with_object(10) do |x, acc|
+		  acc + x
+		end
+		`, 10},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayEachWithObjectMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].each_with_object([])`, "InternalError: Can't yield without a block", 1},
+		{`
+		[1, 2].each_with_object do |e, acc|
+		  acc
+		end
+		`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`
+		[1, 2].each_with_object([], []) do |e, acc|
+		  acc
+		end
+		`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayEmptyMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1033,6 +1773,12 @@ func TestArrayEmptyMethod(t *testing.T) {
 			a = [[]]
 			a.empty?
 			`, false},
+		{
+			`
+			a = [1]
+			a.pop
+			a.empty?
+			`, true},
 	}
 
 	for i, tt := range tests {
@@ -1059,6 +1805,78 @@ func TestArrayEmptyMethodFail(t *testing.T) {
 	}
 }
 
+func TestArrayFindMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		[1, 2, 3, 4].find do |x|
+		  x > 2
+		end
+		`, 3},
+		{`
+		[1, 2, 3, 4].find do |x|
+		  x > 5
+		end
+		`, nil},
+		{`
+		[].find do |x|
+		  true
+		end
+		`, nil},
+		{`
+		["a", "bb", "ccc"].detect do |s|
+		  s.length == 2
+		end
+		`, "bb"},
+		{`
+		visited = []
+		[1, 2, 3, 4, 5].find do |x|
+		  visited.push(x)
+		  x == 2
+		end
+		visited.to_s
+		`, "[1, 2]"},
+		{`
+		visited = []
+		[1, 2, 3, 4, 5].detect do |x|
+		  visited.push(x)
+		  x == 3
+		end
+		visited.length
+		`, 3},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayFindMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].find`, "InternalError: Can't yield without a block", 1},
+		{`[1, 2].detect`, "InternalError: Can't yield without a block", 1},
+		{`
+		[1, 2].find(1) do |x|
+		  x
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayFirstMethod(t *testing.T) {
 	testsInt := []struct {
 		input    string
@@ -1169,6 +1987,15 @@ func TestArrayFlattenMethod(t *testing.T) {
 		{`
 		[[[1, 2], [[[3, 4]], [5, 6]]]].flatten
 		`, []interface{}{1, 2, 3, 4, 5, 6}},
+		{`
+		[[1, 2], nil, [nil, 3]].flatten
+		`, []interface{}{1, 2, nil, nil, 3}},
+		{`
+		[].flatten
+		`, []interface{}{}},
+		{`
+		[[1, [2, 3]], 4].flatten(-1)
+		`, []interface{}{1, 2, 3, 4}},
 	}
 
 	for i, tt := range testsArray {
@@ -1180,11 +2007,203 @@ func TestArrayFlattenMethod(t *testing.T) {
 	}
 }
 
+func TestArrayFlattenMethodWithDepth(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[[1, [2, 3]]].flatten(1).to_s`, "[1, [2, 3]]"},
+		{`[[1, [2, 3]]].flatten(0).to_s`, "[[1, [2, 3]]]"},
+		{`[[1, [2, [3, [4]]]]].flatten(2).to_s`, "[1, 2, [3, [4]]]"},
+		{`[[1, [2, [3, [4]]]]].flatten(10).to_s`, "[1, 2, 3, 4]"},
+		{`
+		a = [[1, [2, 3]]]
+		a.flatten(1)
+		a.to_s
+		`, "[[1, [2, 3]]]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayFlattenMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`a = [1, 2]
-		a.flatten(1)
-		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		a.flatten(1, 2)
+		`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`a = [1, 2]
+		a.flatten("1")
+		`, "TypeError: Expect argument to be Integer. got: String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayFlattenBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		a = [1, [2, [3]]]
+		a.flatten!
+		a.to_s
+		`, "[1, 2, 3]"},
+		{`
+		a = [1, [2, [3]]]
+		a.flatten!.object_id == a.object_id
+		`, true},
+		{`[1, 2, 3].flatten!`, nil},
+		{`[].flatten!`, nil},
+		{`
+		a = [1, [2]]
+		a.flatten!
+		a.flatten!
+		`, nil},
+		{`[[]].flatten!.to_s`, "[]"},
+		{`[[1], 2].flatten!.to_s`, "[1, 2]"},
+		{`[1, [2, [3]]].flatten!(1).to_s`, "[1, 2, [3]]"},
+		{`[1, [2]].flatten!(0)`, nil},
+		{`[1, [2, [3]]].flatten!(-1).to_s`, "[1, 2, 3]"},
+		{`
+		a = [1, [2, [3]]]
+		b = a.flatten
+		a.to_s
+		`, "[1, [2, [3]]]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayFlattenBangMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, [2]].flatten!(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`[1, [2]].flatten!("1")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, [2]].freeze.flatten!`, "FrozenError: Can't modify frozen Array: [1, [2]]", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayIndexMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[10, 20, 30].index(20)`, 1},
+		{`[10, 20, 30].index(40)`, nil},
+		{`[1, "a", nil, [1, 2]].index("a")`, 1},
+		{`[1, "a", nil, [1, 2]].index(nil)`, 2},
+		{`[1, "a", nil, [1, 2]].index([1, 2])`, 3},
+		{`[1, 2, 1].index(1.0)`, 0},
+		{`[].index(1)`, nil},
+		{`
+		[1, 2, 3, 4].index do |x|
+		  x > 2
+		end
+		`, 2},
+		{`
+		[1, 2, 3, 4].index do |x|
+		  x > 10
+		end
+		`, nil},
+		{`
+		[1, 2, 3, 4].index(1) do |x|
+		  x > 2
+		end
+		`, 2},
+		{`
+		[].index do |x|
+		  true
+		end
+		`, nil},
+		{`[10, 20, 30].find_index(30)`, 2},
+		{`
+		[1, 2, 3, 4].find_index do |x|
+		  x.even?
+		end
+		`, 1},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayMethodsCallDefinedEqual(t *testing.T) {
+	point := `
+	class Point
+	  attr_reader :x
+
+	  def initialize(x)
+	    @x = x
+	  end
+
+	  def ==(other)
+	    other.is_a?(Point) && x == other.x
+	  end
+	end
+	`
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[Point.new(1), Point.new(2)].index(Point.new(2))`, 1},
+		{`[Point.new(1)].include?(Point.new(1))`, true},
+		{`[Point.new(1)].include?(Point.new(2))`, false},
+		{`
+		a = [Point.new(1), Point.new(2)]
+		a.delete(Point.new(1))
+		a.length
+		`, 1},
+		{`([Point.new(1), Point.new(2)] - [Point.new(1)]).length`, 1},
+		{`([Point.new(1), Point.new(2)] & [Point.new(2)]).first.x`, 2},
+		{`([Point.new(1)] | [Point.new(1), Point.new(3)]).length`, 2},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, point+tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayIndexMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].index`, "InternalError: Can't yield without a block", 1},
+		{`[1, 2].index(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`[1, 2].find_index`, "InternalError: Can't yield without a block", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1235,11 +2254,82 @@ func TestArrayIncludeMethod(t *testing.T) {
 		`, false},
 	}
 
-	for i, tt := range tests {
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayInsertMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []interface{}
+	}{
+		{`
+		a = [1, 2, 3]
+		a.insert(1, "a", "b")
+		`, []interface{}{1, "a", "b", 2, 3}},
+		{`
+		a = [1, 2, 3]
+		a.insert(0, 0)
+		a
+		`, []interface{}{0, 1, 2, 3}},
+		{`
+		a = [1, 2, 3]
+		a.insert(3, 4)
+		`, []interface{}{1, 2, 3, 4}},
+		{`
+		a = [1, 2, 3]
+		a.insert(-1, 4)
+		`, []interface{}{1, 2, 3, 4}},
+		{`
+		a = [1, 2, 3]
+		a.insert(-2, "a")
+		`, []interface{}{1, 2, "a", 3}},
+		{`
+		a = [1, 2, 3]
+		a.insert(-4, 0)
+		`, []interface{}{0, 1, 2, 3}},
+		{`
+		a = [1]
+		a.insert(3, 5)
+		`, []interface{}{1, nil, nil, 5}},
+		{`
+		a = [1, 2]
+		a.insert(1)
+		`, []interface{}{1, 2}},
+		{`
+		a = [1, 2, 3]
+		b = a.first(2)
+		a.insert(1, 5)
+		b
+		`, []interface{}{1, 2}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayInsertMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].insert`, "ArgumentError: Expect 1 or more argument(s). got: 0", 1},
+		{`[1, 2].insert("a", 1)`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, 2].insert(-4, 1)`, "ArgumentError: Index value -4 too small for array. minimum: -3", 1},
+	}
+
+	for i, tt := range testsFail {
 		v := initTestVM()
 		evaluated := v.testEval(t, tt.input, getFilename())
-		VerifyExpected(t, i, evaluated, tt.expected)
-		v.checkCFP(t, i, 0)
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
 		v.checkSP(t, i, 1)
 	}
 }
@@ -1263,6 +2353,10 @@ func TestArrayJoinMethod(t *testing.T) {
 		`, "1,2,3,4"},
 		{`[[:h, :e, :l], [[:l], :o]].join`, "hello"},
 		{`[[:hello],{k: :v}].join `, `hello{ k: "v" }`},
+		{`[1, "a", true].join("-")`, "1-a-true"},
+		{`[1, nil, false, nil].join(",")`, "1,,false,"},
+		{`[1, [2, [nil, 3]]].join("-")`, "1-2--3"},
+		{`[].join(",")`, ""},
 	}
 
 	for i, tt := range testsInt {
@@ -1282,6 +2376,7 @@ func TestArrayJoinMethodFail(t *testing.T) {
 		{`a = [1, 2]
 		a.join(1)
 		`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`[1, 2].join(nil)`, "TypeError: Expect argument to be String. got: Null", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1472,6 +2567,80 @@ func TestArrayPlusOperator(t *testing.T) {
 			b = []
 			a + b
 		`, []interface{}{}},
+		// The array with spare capacity shouldn't be shared with the results.
+		{`
+			a = [1, 2, 3]
+			a.push(4)
+			b = a + [5]
+			c = a + [6]
+			b
+		`, []interface{}{1, 2, 3, 4, 5}},
+		{`
+			a = [1, 2]
+			b = [3]
+			a + b
+			a + a
+			a + b
+			a
+		`, []interface{}{1, 2}},
+		{`
+			a = [1, 2]
+			b = [3]
+			a + b
+			b
+		`, []interface{}{3}},
+	}
+
+	for i, tt := range tests {
+		vm := initTestVM()
+		evaluated := vm.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		vm.checkCFP(t, i, 0)
+		vm.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayPlusOperatorFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2] + true`, "TypeError: Expect argument to be Array. got: Boolean", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArraySetOperators(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []interface{}
+	}{
+		{`[1, 2, 3, 2] - [2]`, []interface{}{1, 3}},
+		{`[1, 2, 3] - []`, []interface{}{1, 2, 3}},
+		{`[] - [1]`, []interface{}{}},
+		{`[1, "a", :b, 1.0] - ["b", 1]`, []interface{}{"a"}},
+		{`[[1, 2], [3]] - [[1, 2]]`, []interface{}{[]interface{}{3}}},
+		{`[1, 1, 3, 5] & [3, 2, 1]`, []interface{}{1, 3}},
+		{`[1, 2] & [3]`, []interface{}{}},
+		{`["a", "b", "a"] & ["a"]`, []interface{}{"a"}},
+		{`[1, 2, 2] | [2, 3]`, []interface{}{1, 2, 3}},
+		{`["a"] | ["b", "a"]`, []interface{}{"a", "b"}},
+		{`[] | []`, []interface{}{}},
+		{`[1, 2] | [3] & [3, 4]`, []interface{}{1, 2, 3}},
+		{`([1, 2] | [3]) & [3, 4]`, []interface{}{3}},
+		{`[1, 2, 3] - [1] | [1]`, []interface{}{2, 3, 1}},
+		{`
+			a = [1, 2, 3]
+			b = [2]
+			a - b
+			a | b
+			a & b
+			a
+		`, []interface{}{1, 2, 3}},
 	}
 
 	for i, tt := range tests {
@@ -1483,9 +2652,334 @@ func TestArrayPlusOperator(t *testing.T) {
 	}
 }
 
-func TestArrayPlusOperatorFail(t *testing.T) {
+func TestArraySetOperatorsFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2] - 1`, "TypeError: Expect argument to be Array. got: Integer", 1},
+		{`[1, 2] & "a"`, "TypeError: Expect argument to be Array. got: String", 1},
+		{`[1, 2] | nil`, "TypeError: Expect argument to be Array. got: Null", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayMaxByMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		["a", "ccc", "bb"].max_by do |s|
+		  s.size
+		end
+		`, "ccc"},
+		{`
+		[3, 1, 2].max_by do |i|
+		  -i
+		end
+		`, 1},
+		{`
+		["bb", "a", "ccc"].max_by do |s|
+		  s
+		end
+		`, "ccc"},
+		{`
+		["ab", "cd", "e"].max_by do |s|
+		  s.size
+		end
+		`, "ab"},
+		{`
+		[1.5, 2, 0.5].max_by do |i|
+		  i
+		end.to_s
+		`, "2"},
+		{`
+		[].max_by do |i|
+		  i
+		end
+		`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayMaxByMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].max_by`, "InternalError: Can't yield without a block", 1},
+		{`[1, 2].max_by(1) do |i|
+		  i
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`[1, "a"].max_by do |i|
+		  i
+		end
+		`, "ArgumentError: Comparison of Integer with String failed", 1},
+		{`["a", 1].max_by do |i|
+		  i
+		end
+		`, "ArgumentError: Comparison of String with Integer failed", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayMinByMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		["a", "ccc", "bb"].min_by do |s|
+		  s.size
+		end
+		`, "a"},
+		{`
+		[3, 1, 2].min_by do |i|
+		  -i
+		end
+		`, 3},
+		{`
+		["bb", "a", "ccc"].min_by do |s|
+		  s
+		end
+		`, "a"},
+		{`
+		["ab", "cd", "e"].min_by do |s|
+		  s.size
+		end
+		`, "e"},
+		{`
+		[1.5, 2, 0.5].min_by do |i|
+		  i
+		end.to_s
+		`, "0.5"},
+		{`
+		[].min_by do |i|
+		  i
+		end
+		`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayMinByMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].min_by`, "InternalError: Can't yield without a block", 1},
+		{`[1, 2].min_by(1) do |i|
+		  i
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`[1, "a"].min_by do |i|
+		  i
+		end
+		`, "ArgumentError: Comparison of Integer with String failed", 1},
+		{`["a", 1].min_by do |i|
+		  i
+		end
+		`, "ArgumentError: Comparison of String with Integer failed", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayNoneMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+			[1, 2, 3].none? do |e|
+			  e > 3
+			end
+		`, true},
+		{`
+			[1, 2, 3].none? do |e|
+			  e > 2
+			end
+		`, false},
+		{`
+			[].none? do |e|
+			  true
+			end
+		`, true},
+		{`[nil, false].none?`, true},
+		{`[nil, 1].none?`, false},
+		{`[].none?`, true},
+		// cases for providing an empty block
+		{`
+			[1, 2, 3].none? do end
+		`, true},
+		// short-circuits on the first success
+		{`
+			count = 0
+			[1, 2, 3].none? do |e|
+			  count += 1
+			  e == 2
+			end
+			count
+		`, 2},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayNoneMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[].none?(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayPartitionMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		[1, 2, 3, 4, 5].partition do |x|
+		  x > 2
+		end.to_s
+		`, "[[3, 4, 5], [1, 2]]"},
+		{`
+		[1, nil, "a", false].partition do |x|
+		  x
+		end.to_s
+		`, `[[1, "a"], [nil, false]]`},
+		{`
+		[].partition do |x|
+		  x > 2
+		end.to_s
+		`, "[[], []]"},
+		{`
+		[1, 2].partition do |x|
+		end.to_s
+		`, "[[], [1, 2]]"},
+		{`
+		a = [1, 2, 3]
+		a.partition do |x|
+		  x.even?
+		end
+		a.to_s
+		`, "[1, 2, 3]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayPartitionMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].partition`, "InternalError: Can't yield without a block", 1},
+		{`
+		[1, 2].partition(1) do |x|
+		  x
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayPermutationMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2, 3].permutation(2).to_s`, "[[1, 2], [1, 3], [2, 1], [2, 3], [3, 1], [3, 2]]"},
+		{`[1, 2, 3].permutation(1).to_s`, "[[1], [2], [3]]"},
+		{`[1, 2, 3].permutation(3).to_s`, "[[1, 2, 3], [1, 3, 2], [2, 1, 3], [2, 3, 1], [3, 1, 2], [3, 2, 1]]"},
+		{`[1, 2, 3].permutation(0).to_s`, "[[]]"},
+		{`[1, 2, 3].permutation(4).to_s`, "[]"},
+		{`[1, 2, 3, 4, 5].permutation(3).length`, 60},
+		{`
+		a = []
+		[1, 2, 3].permutation(2) do |p|
+		  a.push(p[0] * 10 + p[1])
+		end
+		a.to_s
+		`, "[12, 13, 21, 23, 31, 32]"},
+		{`
+		a = [1, 2, 3]
+		a.permutation(2) do |p|
+		end.object_id == a.object_id
+		`, true},
+		{`
+		a = [1, 2, 3]
+		a.permutation(4) do |p|
+		  puts(p)
+		end.to_s
+		`, "[1, 2, 3]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayPermutationMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
-		{`[1, 2] + true`, "TypeError: Expect argument to be Array. got: Boolean", 1},
+		{`[1, 2].permutation`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`[1, 2].permutation(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`[1, 2].permutation("a")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, 2].permutation(-1)`, "ArgumentError: Expect argument to be positive value. got: -1", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1543,6 +3037,56 @@ func TestArrayPopMethodFail(t *testing.T) {
 	}
 }
 
+func TestArrayProductMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2].product([3, 4]).to_s`, "[[1, 3], [1, 4], [2, 3], [2, 4]]"},
+		{`[1, 2].product([3], [5, 6]).to_s`, "[[1, 3, 5], [1, 3, 6], [2, 3, 5], [2, 3, 6]]"},
+		{`[1, 2].product.to_s`, "[[1], [2]]"},
+		{`[1, 2].product([]).to_s`, "[]"},
+		{`[].product([1, 2]).to_s`, "[]"},
+		{`[[1], 2].product(["a"]).to_s`, `[[[1], "a"], [2, "a"]]`},
+		{`
+		a = [1, 2, 3, 4, 5, 6, 7, 8, 9, 10]
+		a.product(a, a).length
+		`, 1000},
+		{`
+		a = [1, 2, 3, 4, 5, 6, 7, 8, 9, 10]
+		a.product(a, a).last.to_s
+		`, "[10, 10, 10]"},
+		{`
+		a = [1, 2]
+		a.product([3])
+		a.to_s
+		`, "[1, 2]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayProductMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].product(1)`, "TypeError: Expect argument to be Array. got: Integer", 1},
+		{`[1, 2].product([1], "a")`, "TypeError: Expect argument to be Array. got: String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayPushMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1580,6 +3124,79 @@ func TestArrayPushMethod(t *testing.T) {
 		{`
 			[].push(nil, "", '').to_s
 	`, `[nil, "", ""]`},
+		{`
+			a = [1]
+			a.push(2, 3, 4)
+			a.length
+			`, 4},
+		{`
+			a = [1]
+			a.push(2, 3).object_id == a.object_id
+			`, true},
+		{`
+			a = [1]
+			a.push
+			a.to_s
+			`, "[1]"},
+		{`
+			a = [1]
+			a.append(2, 3)
+			a.to_s
+			`, "[1, 2, 3]"},
+		{`
+			a = []
+			a.append(nil).append([1]).to_s
+			`, "[nil, [1]]"},
+		{`
+			a = [1]
+			a.concat([2]).object_id == a.object_id
+			`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayAppendOperator(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+			a = [1, 2]
+			a << 3
+			a.to_s
+			`, "[1, 2, 3]"},
+		{`
+			a = []
+			a << 1 << 2 << 3
+			a.length
+			`, 3},
+		{`
+			a = [1]
+			b = a << 2
+			b.object_id == a.object_id
+			`, true},
+		{`
+			a = []
+			a << nil << [1] << "foo"
+			a.to_s
+			`, `[nil, [1], "foo"]`},
+		{`
+			a = [1]
+			a << 1 + 1
+			a.to_s
+			`, "[1, 2]"},
+		{`
+			a = [[1]]
+			a[0] << 2
+			a.to_s
+			`, "[[1, 2]]"},
 	}
 
 	for i, tt := range tests {
@@ -1591,6 +3208,21 @@ func TestArrayPushMethod(t *testing.T) {
 	}
 }
 
+func TestArrayAppendOperatorFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].freeze << 3`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].send("<<")`, "ArgumentError: Expect 1 argument(s). got: 0", 2},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayReduceMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1625,6 +3257,41 @@ func TestArrayReduceMethod(t *testing.T) {
 			true
 		end
 		`, "foo"},
+		{`
+		[1, 2, 3, 4].reduce(0) do |s, x|
+			s + x
+		end
+		`, 10},
+		{`
+		[].reduce(5) do |s, x|
+			s + x
+		end
+		`, 5},
+		{`
+		[].reduce do |s, x|
+			s + x
+		end
+		`, nil},
+		{`
+		[42].reduce do |s, x|
+			s + x
+		end
+		`, 42},
+		{`
+		[1, 2, 3, 4].inject(0) do |s, x|
+			s + x
+		end
+		`, 10},
+		{`
+		[1, 2, 3, 4].inject do |s, x|
+			s * x
+		end
+		`, 24},
+		{`
+		[].inject do |s, x|
+			s + x
+		end
+		`, nil},
 		// cases for providing an empty block
 		{`
 		a = [1, 2, 3].reduce() do; end
@@ -1679,6 +3346,14 @@ func TestArrayReduceMethodFail(t *testing.T) {
 			prev + n
 		end
 		`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`a = [1, 2]
+		a.inject(1)
+		`, "InternalError: Can't yield without a block", 1},
+		{`a = [1, 2]
+		a.inject(1, 2) do |prev, n|
+			prev + n
+		end
+		`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1703,6 +3378,28 @@ func TestArrayReverseMethod(t *testing.T) {
 		a = []
 		a.reverse
 		`, []interface{}{}},
+		{`
+		a = [1]
+		a.reverse
+		`, []interface{}{1}},
+		{`
+		a = [1, 2, 3]
+		a.reverse
+		a
+		`, []interface{}{1, 2, 3}},
+		{`
+		a = [1, 2, 3, 4]
+		a.reverse!
+		`, []interface{}{4, 3, 2, 1}},
+		{`
+		a = [1, 2, 3]
+		a.reverse!
+		a
+		`, []interface{}{3, 2, 1}},
+		{`
+		a = []
+		a.reverse!
+		`, []interface{}{}},
 	}
 
 	for i, tt := range tests {
@@ -1714,10 +3411,39 @@ func TestArrayReverseMethod(t *testing.T) {
 	}
 }
 
+func TestArrayReverseMethodReturnValueIdentity(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		a = [1, 2, 3]
+		a.reverse.object_id == a.object_id
+		`, false},
+		{`
+		a = []
+		a.reverse.object_id == a.object_id
+		`, false},
+		{`
+		a = [1, 2, 3]
+		a.reverse!.object_id == a.object_id
+		`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArrayReverseMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`[1, 2, 3, 4, 5].reverse(123)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
 		{`[1, 2, 3, 4, 5].reverse("Hello", "World")`, "ArgumentError: Expect 0 argument(s). got: 2", 1},
+		{`[1, 2, 3, 4, 5].reverse!(123)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1816,24 +3542,172 @@ func TestArrayRotateMethod(t *testing.T) {
 		a = [1, 2, 3, 4]
 		a.rotate(-1)
 		`, []interface{}{4, 1, 2, 3}},
+		{`
+		a = [1, 2, 3, 4]
+		a.rotate(-6)
+		`, []interface{}{3, 4, 1, 2}},
+		{`
+		a = [1, 2, 3, 4]
+		a.rotate(9)
+		`, []interface{}{2, 3, 4, 1}},
+		{`
+		a = [1, 2, 3, 4]
+		a.rotate(4)
+		`, []interface{}{1, 2, 3, 4}},
+		{`
+		a = [1, 2, 3, 4]
+		a.rotate(1000000000)
+		`, []interface{}{1, 2, 3, 4}},
+		{`
+		a = [1, 2, 3, 4]
+		a.rotate(2)
+		a
+		`, []interface{}{1, 2, 3, 4}},
+		{`[].rotate`, []interface{}{}},
+		{`[].rotate(-3)`, []interface{}{}},
+		{`
+		a = [1, 2, 3, 4]
+		a.rotate!
+		`, []interface{}{2, 3, 4, 1}},
+		{`
+		a = [1, 2, 3, 4]
+		a.rotate!(-1)
+		a
+		`, []interface{}{4, 1, 2, 3}},
+		{`
+		a = [1, 2, 3, 4]
+		a.rotate!(6).rotate!(-7)
+		a
+		`, []interface{}{4, 1, 2, 3}},
+		{`
+		a = []
+		a.rotate!(5)
+		`, []interface{}{}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayRotateBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		a = [1, 2, 3]
+		a.rotate!.object_id == a.object_id
+		`, true},
+		{`
+		a = [1, 2, 3]
+		a.rotate.object_id == a.object_id
+		`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayRotateMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`a = [1, 2]
+		a.rotate("a")
+		`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`a = [1, 2]
+		a.rotate(1, 2, 3)`, "ArgumentError: Expect 1 or less argument(s). got: 3", 1},
+		{`[1, 2].rotate!(1.5)`, "TypeError: Expect argument to be Integer. got: Float", 1},
+		{`[1, 2].rotate!(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`[1, 2].freeze.rotate!`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArraySampleMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[].sample`, nil},
+		{`[5].sample`, 5},
+		{`[1, 2, 3].include?([1, 2, 3].sample)`, true},
+		{`[1, 2, 3].sample(2).length`, 2},
+		{`[1, 2, 3].sample(0).length`, 0},
+		{`[1, 2, 3].sample(10).sort.to_s`, "[1, 2, 3]"},
+		{`[1, 1, 1].sample(3).to_s`, "[1, 1, 1]"},
+		{`[].sample(2).to_s`, "[]"},
+		{`
+		a = []
+		100.times do |i|
+		  a.push(i)
+		end
+		h = {}
+		a.sample(60).each do |i|
+		  h[i.to_s] = i
+		end
+		h.length
+		`, 60},
+		{`
+		a = []
+		100.times do |i|
+		  a.push(i)
+		end
+		a.sample(100).sort.to_s == a.to_s
+		`, true},
+		{`
+		a = [1, 2, 3, 4]
+		b = a.sample(4)
+		a.to_s
+		`, "[1, 2, 3, 4]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
 	}
+}
 
-	for i, tt := range tests {
+func TestArraySampleMethodWithSeed(t *testing.T) {
+	input := `[1, 2, 3, 4, 5, 6, 7, 8].sample(4).to_s`
+
+	v := initTestVM()
+	v.SetRandomSeed(42)
+	expected := v.testEval(t, input, getFilename()).ToString()
+
+	for i := 0; i < 3; i++ {
 		v := initTestVM()
-		evaluated := v.testEval(t, tt.input, getFilename())
-		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.SetRandomSeed(42)
+		evaluated := v.testEval(t, input, getFilename())
+		VerifyExpected(t, i, evaluated, expected)
 		v.checkCFP(t, i, 0)
 		v.checkSP(t, i, 1)
 	}
 }
 
-func TestArrayRotateMethodFail(t *testing.T) {
+func TestArraySampleMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
-		{`a = [1, 2]
-		a.rotate("a")
-		`, "TypeError: Expect argument to be Integer. got: String", 1},
-		{`a = [1, 2]
-		a.rotate(1, 2, 3)`, "ArgumentError: Expect 1 or less argument(s). got: 3", 1},
+		{`[1, 2].sample(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`[1, 2].sample("a")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, 2].sample(-1)`, "ArgumentError: Expect argument to be positive value. got: -1", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1960,6 +3834,119 @@ func TestArrayShiftMethodFail(t *testing.T) {
 	}
 }
 
+func TestArrayShuffleMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2, 3, 4, 5].shuffle.to_s`, "[3, 4, 5, 1, 2]"},
+		{`[1, 2, 3, 4, 5].shuffle!.to_s`, "[3, 4, 5, 1, 2]"},
+		{`[].shuffle.to_s`, "[]"},
+		{`[1, 2, 3].shuffle.sort.to_s`, "[1, 2, 3]"},
+		{`
+		a = [1, 2, 3, 4, 5]
+		a.shuffle
+		a.to_s
+		`, "[1, 2, 3, 4, 5]"},
+		{`
+		a = [1, 2, 3, 4, 5]
+		a.shuffle.object_id == a.object_id
+		`, false},
+		{`
+		a = [1, 2, 3, 4, 5]
+		a.shuffle!
+		a.to_s
+		`, "[3, 4, 5, 1, 2]"},
+		{`
+		a = [1, 2, 3, 4, 5]
+		a.shuffle!.object_id == a.object_id
+		`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetRandomSeed(42)
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayShuffleMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].shuffle(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`[1, 2].shuffle!(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArraySliceMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2, 3, 4, 5].slice(1)`, 2},
+		{`[1, 2, 3, 4, 5].slice(-1)`, 5},
+		{`[1, 2, 3, 4, 5].slice(5)`, nil},
+		{`[1, 2, 3, 4, 5].slice(1, 2).to_s`, "[2, 3]"},
+		{`[1, 2, 3, 4, 5].slice(-2, 5).to_s`, "[4, 5]"},
+		{`[1, 2, 3, 4, 5].slice(5, 1).to_s`, "[]"},
+		{`[1, 2, 3, 4, 5].slice(6, 1)`, nil},
+		{`[1, 2, 3, 4, 5].slice(1..3).to_s`, "[2, 3, 4]"},
+		{`[1, 2, 3, 4, 5].slice(1..10).to_s`, "[2, 3, 4, 5]"},
+		{`[1, 2, 3, 4, 5].slice(1..-2).to_s`, "[2, 3, 4]"},
+		{`[1, 2, 3, 4, 5].slice(-3..-1).to_s`, "[3, 4, 5]"},
+		{`[1, 2, 3, 4, 5].slice(3..1).to_s`, "[]"},
+		{`[1, 2, 3, 4, 5].slice(5..6).to_s`, "[]"},
+		{`[1, 2, 3, 4, 5].slice(6..7)`, nil},
+		{`[1, 2, 3, 4, 5].slice(-6..1)`, nil},
+		{`[1, 2, 3, 4, 5][1..3].to_s`, "[2, 3, 4]"},
+		{`[1, 2, 3, 4, 5][-2..-1].to_s`, "[4, 5]"},
+		{`[1, 2, 3, 4, 5][6..7]`, nil},
+		{`[][0..1].to_s`, "[]"},
+		{`[1, 2, 3, 4, 5][1...3].to_s`, "[2, 3]"},
+		{`[1, 2, 3, 4, 5][1...-1].to_s`, "[2, 3, 4]"},
+		{`[1, 2, 3, 4, 5][-3...5].to_s`, "[3, 4, 5]"},
+		{`[1, 2, 3, 4, 5][1...10].to_s`, "[2, 3, 4, 5]"},
+		{`[1, 2, 3, 4, 5][2...2].to_s`, "[]"},
+		{`[1, 2, 3, 4, 5][6...7]`, nil},
+		{`[1, 2, 3, 4, 5].slice(0...-2).to_s`, "[1, 2, 3]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArraySliceMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].slice`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`[1, 2].slice("a")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, 2].slice(1..2, 1)`, "TypeError: Expect argument to be Integer. got: Range", 1},
+		{`[1, 2].slice(1, -1)`, "ArgumentError: Expect second argument to be positive value. got: -1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestArraySortMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1980,6 +3967,33 @@ func TestArraySortMethod(t *testing.T) {
 		{`
 		["abc", "aaaaaa"].sort
 		`, []interface{}{"aaaaaa", "abc"}},
+		{`
+		[].sort
+		`, []interface{}{}},
+		{`
+		[3, 1, 2].sort do |a, b|
+		  b <=> a
+		end
+		`, []interface{}{3, 2, 1}},
+		{`
+		["bb", "a", "ccc"].sort do |a, b|
+		  a.length <=> b.length
+		end
+		`, []interface{}{"a", "bb", "ccc"}},
+		{`
+		# stable sort keeps the original order of the equal elements
+		["b", "a", "d", "c"].sort do |a, b|
+		  0
+		end
+		`, []interface{}{"b", "a", "d", "c"}},
+		{`
+		[].sort do |a, b|
+		  a <=> b
+		end
+		`, []interface{}{}},
+		{`
+		[[2, 1], [1, 2], [1]].sort
+		`, []interface{}{[]interface{}{1}, []interface{}{1, 2}, []interface{}{2, 1}}},
 	}
 
 	for i, tt := range tests {
@@ -1991,12 +4005,220 @@ func TestArraySortMethod(t *testing.T) {
 	}
 }
 
+func TestArraySortMethodDoesNotMutateReceiver(t *testing.T) {
+	input := `
+	a = [3, 1, 2]
+	a.sort
+	a.sort do |x, y|
+	  x <=> y
+	end
+	a
+	`
+
+	v := initTestVM()
+	evaluated := v.testEval(t, input, getFilename())
+	verifyArrayObject(t, 0, evaluated, []interface{}{3, 1, 2})
+	v.checkCFP(t, 0, 0)
+	v.checkSP(t, 0, 1)
+}
+
 func TestArraySortMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`a = [1, 2]
 		a.sort(3, 3, 4, 5)
 		`,
 			"ArgumentError: Expect 0 argument. got=4", 1},
+		{`[1, "a"].sort`, "ArgumentError: Comparison of Integer with String failed", 1},
+		{`["a", 1].sort`, "ArgumentError: Comparison of String with Integer failed", 1},
+		{`[1, 2, "a"].sort`, "ArgumentError: Comparison of Integer with String failed", 1},
+		{`[nil, nil].sort`, "ArgumentError: Comparison of Null with Null failed", 1},
+		{`[1, 2].sort do |a, b|
+		  "a"
+		end
+		`, "TypeError: Expect the block to return Integer. got: String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArraySortByMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []interface{}
+	}{
+		{`
+		["ccc", "a", "bb"].sort_by do |s|
+		  s.length
+		end
+		`, []interface{}{"a", "bb", "ccc"}},
+		{`
+		[3, 1, 2].sort_by do |i|
+		  -i
+		end
+		`, []interface{}{3, 2, 1}},
+		{`
+		[[2, "b"], [1, "c"], [3, "a"]].sort_by do |pair|
+		  pair[1]
+		end.map do |pair|
+		  pair[0]
+		end
+		`, []interface{}{3, 2, 1}},
+		{`
+		# stable sort keeps the original order of the elements with equal keys
+		["bb", "a", "dd", "c"].sort_by do |s|
+		  s.length
+		end
+		`, []interface{}{"a", "c", "bb", "dd"}},
+		{`
+		[].sort_by do |i|
+		  i
+		end
+		`, []interface{}{}},
+		{`
+		a = [3, 1, 2]
+		a.sort_by do |i|
+		  i
+		end
+		a
+		`, []interface{}{3, 1, 2}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArraySortByMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].sort_by`, "InternalError: Can't yield without a block", 1},
+		{`[1, 2].sort_by(1) do |i|
+		  i
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`[1, "a"].sort_by do |i|
+		  i
+		end
+		`, "ArgumentError: Comparison of Integer with String failed", 1},
+		{`["a", 1].sort_by do |i|
+		  i
+		end
+		`, "ArgumentError: Comparison of String with Integer failed", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayTakeWhileMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []interface{}
+	}{
+		{`
+		[1, 2, 3, 1].take_while do |x|
+		  x < 3
+		end
+		`, []interface{}{1, 2}},
+		{`
+		[1, 2, 3].take_while do |x|
+		  x < 10
+		end
+		`, []interface{}{1, 2, 3}},
+		{`
+		[1, 2, 3].take_while do |x|
+		  x > 10
+		end
+		`, []interface{}{}},
+		{`
+		[].take_while do |x|
+		  true
+		end
+		`, []interface{}{}},
+		{`
+		count = 0
+		[1, 2, 3, 4].take_while do |x|
+		  count += 1
+		  x < 2
+		end
+		[count]
+		`, []interface{}{2}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayTakeWhileMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].take_while`, "InternalError: Can't yield without a block", 1},
+		{`
+		[1, 2].take_while(1) do |x|
+		  x
+		end
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayTallyMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`["a", "b", "a", "c", "a"].tally.to_s`, `{ a: 3, b: 1, c: 1 }`},
+		{`["a", "b", "a", "c", "a"].tally["a"]`, 3},
+		{`[1, 2, 1, true, true].tally.to_s`, `{ 1: 2, 2: 1, true: 2 }`},
+		{`[1, "a", 1, "b", "a", false].tally.to_s`, `{ 1: 2, a: 2, b: 1, false: 1 }`},
+		{`["c", "a", "c", "b"].tally.to_s`, `{ c: 2, a: 1, b: 1 }`},
+		{`["c", "a", "c", "b"].tally.keys`, []interface{}{"c", "a", "b"}},
+		{`[1, "1"].tally.to_s`, `{ 1: 2 }`},
+		{`[].tally.length`, 0},
+		{`
+		a = ["a", "b", "a"]
+		a.tally
+		a.to_s
+		`, `["a", "b", "a"]`},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestArrayTallyMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].tally(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -2197,6 +4419,9 @@ func TestArrayValuesAtMethod(t *testing.T) {
 			a = []
 			a.values_at(1, -1)
 			`, []interface{}{nil, nil}},
+		{`[1, 2, 3, 4].values_at(0, 2, -1)`, []interface{}{1, 3, 4}},
+		{`[1, 2, 3, 4].values_at(3, 0, 3)`, []interface{}{4, 1, 4}},
+		{`[1, 2, 3, 4].values_at(-4, -5, 4)`, []interface{}{1, nil, nil}},
 	}
 
 	for i, tt := range tests {
@@ -2235,6 +4460,7 @@ func TestArrayValuesAtMethodFail(t *testing.T) {
 		{`a = ["a", "b", "c"]
 			a.values_at("-")
 		`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`[1, 2].values_at(0, nil)`, "TypeError: Expect argument to be Integer. got: Null", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -2259,8 +4485,45 @@ b = a.dup
 a[0] = 10
 b
 `, []interface{}{1, 2, 3}},
+		{`
+a = [1,2,3]
+b = a.dup
+b.push(4)
+a
+`, []interface{}{1, 2, 3}},
+		{`
+a = [[1], 2]
+b = a.dup
+b[0].push(3)
+a.to_s
+`, "[[1, 3], 2]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
 	}
+}
 
+func TestArrayZipMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2].zip([3, 4], [5, 6]).to_s`, "[[1, 3, 5], [2, 4, 6]]"},
+		{`[1, 2, 3].zip([4]).to_s`, "[[1, 4], [2, nil], [3, nil]]"},
+		{`[1].zip([2, 3], [4, 5, 6]).to_s`, "[[1, 2, 4]]"},
+		{`[1, 2].zip.to_s`, "[[1], [2]]"},
+		{`[].zip([1, 2]).to_s`, "[]"},
+		{`
+		a = [1, 2]
+		a.zip([3, 4])
+		a.to_s
+		`, "[1, 2]"},
+	}
 
 	for i, tt := range tests {
 		v := initTestVM()
@@ -2270,3 +4533,18 @@ b
 		v.checkSP(t, i, 1)
 	}
 }
+
+func TestArrayZipMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].zip(1)`, "TypeError: Expect argument to be Array. got: Integer", 1},
+		{`[1, 2].zip([1], "a")`, "TypeError: Expect argument to be Array. got: String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
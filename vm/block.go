@@ -45,11 +45,27 @@ import (
 // #=> 4
 // ```
 //
+// A method can capture its block as a block object with a `&` parameter,
+// and a block object can be passed to a method as its block with `&`:
+//
+// ```ruby
+// def capture(&block)
+//   block
+// end
+//
+// bl = capture do |i|
+//   i * 2
+// end
+// bl.call(5)       #=> 10
+// [1, 2].map(&bl)  #=> [2, 4]
+// ```
+//
 type BlockObject struct {
 	*BaseObj
 	instructionSet *instructionSet
 	ep             *normalCallFrame
 	self           Object
+	lambda         bool
 }
 
 // Class methods --------------------------------------------------------
@@ -105,11 +121,25 @@ var builtinBlockInstanceMethods = []*BuiltinMethodObject{
 		// p.call                    #=> [nil, nil, nil]
 		// ```
 		//
+		// Lambdas check the number of the arguments instead:
+		//
+		// ```ruby
+		// l = lambda do |i, j|
+		//   [i, j]
+		// end
+		// l.call(1)                 #=> ArgumentError
+		// ```
+		//
 		// @param object [Object]...
 		// @return [Object]
 		Name: "call",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			block := receiver.(*BlockObject)
+
+			if block.lambda && len(args) != block.paramsCount() {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, block.paramsCount(), len(args))
+			}
+
 			c := newNormalCallFrame(block.instructionSet, block.instructionSet.filename, sourceLine)
 			c.ep = block.ep
 			c.self = block.self
@@ -118,6 +148,20 @@ var builtinBlockInstanceMethods = []*BuiltinMethodObject{
 			return t.builtinMethodYield(c, args...).Target
 		},
 	},
+	{
+		// Returns true if the block object is a lambda.
+		//
+		// ```ruby
+		// -> { 1 }.lambda?           #=> true
+		// Block.new { 1 }.lambda?    #=> false
+		// ```
+		//
+		// @return [Boolean]
+		Name: "lambda?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return toBooleanObject(receiver.(*BlockObject).lambda)
+		},
+	},
 }
 
 // Internal functions ===================================================
@@ -140,6 +184,15 @@ func (vm *VM) initBlockObject(is *instructionSet, ep *normalCallFrame, self Obje
 	}
 }
 
+// paramsCount returns the number of the block's parameters
+func (bo *BlockObject) paramsCount() int {
+	if bo.instructionSet.paramTypes == nil {
+		return 0
+	}
+
+	return len(bo.instructionSet.paramTypes.Types())
+}
+
 // Polymorphic helper functions -----------------------------------------
 
 // Value returns the object
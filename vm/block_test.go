@@ -59,3 +59,305 @@ p.call(1, 2, 3, 4, 5)`, []interface{}{1, 2, 3}},
 		v.checkSP(t, i, 1)
 	}
 }
+
+func TestBlockArgument(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+def capture(&block)
+  block
+end
+
+b = capture do |x|
+  x * 10
+end
+
+b.call(5)
+`, 50},
+		{`
+def capture(&block)
+  block
+end
+
+n = 0
+b = capture do |x|
+  n += x
+end
+
+b.call(2)
+b.call(3)
+n
+`, 5},
+		{`
+class Store
+  def save(&block)
+    @block = block
+  end
+
+  def run(x)
+    @block.call(x)
+  end
+end
+
+s = Store.new
+s.save do |x|
+  x + 1
+end
+s.run(1) + s.run(2)
+`, 5},
+		{`
+def foo(a, &block)
+  block.call(a) + yield(a)
+end
+
+foo(10) do |x|
+  x * 2
+end
+`, 40},
+		{`
+def foo(&block)
+  block
+end
+
+foo
+`, nil},
+		{`
+def foo(a, &block)
+  block_given?
+end
+
+foo(1)
+`, false},
+		{`
+def foo(x)
+  yield(x)
+end
+
+b = Block.new do |x|
+  x * x
+end
+
+foo(3, &b)
+`, 9},
+		{`
+b = Block.new do |x|
+  x * x
+end
+
+[1, 2, 3].map(&b).to_s
+`, "[1, 4, 9]"},
+		{`
+def foo(&block)
+  [1, 2].map(&block)
+end
+
+r = foo do |x|
+  x + 1
+end
+r.to_s
+`, "[2, 3]"},
+		{`
+class Foo
+  def initialize
+    @value = 7
+  end
+
+  def block
+    Block.new do
+      @value
+    end
+  end
+end
+
+def call(&block)
+  block.call
+end
+
+call(&Foo.new.block)
+`, 7},
+		{`
+def foo
+  block_given?
+end
+
+foo(&nil)
+`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestBlockArgumentFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1, 2].map(&1)`, "TypeError: Expect argument to be Block. got: Integer", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestBlockGivenMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+def foo
+  block_given?
+end
+
+foo
+`, false},
+		{`
+def foo
+  block_given?
+end
+
+foo do
+end
+`, true},
+		{`
+def foo(x)
+  if block_given?
+    yield(x)
+  else
+    x
+  end
+end
+
+foo(10)
+`, 10},
+		{`
+def foo(x)
+  if block_given?
+    yield(x)
+  else
+    x
+  end
+end
+
+foo(10) do |x|
+  x * 2
+end
+`, 20},
+		{`
+class Foo
+  def bar
+    block_given?
+  end
+end
+
+Foo.new.bar do
+  1
+end
+`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestLambdaAndProc(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+add = lambda do |x, y|
+  x + y
+end
+add.call(1, 2)
+`, 3},
+		{`
+inc = lambda { |x| x + 1 }
+inc.call(1)
+`, 2},
+		{`
+double = -> (x) { x * 2 }
+double.(4)
+`, 8},
+		{`
+sub = ->(x, y) do
+  x - y
+end
+sub.call(5, 3)
+`, 2},
+		{`
+n = 10
+counter = -> { n += 1 }
+counter.()
+counter.call
+n
+`, 12},
+		{`
+def make_counter
+  count = 0
+  -> { count += 1 }
+end
+
+c = make_counter
+c.call
+c.call
+`, 2},
+		{`
+p = proc { |a, b| [a, b] }
+p.call(1).to_s
+`, "[1, nil]"},
+		{`
+p = proc do |a, b|
+  [a, b]
+end
+p.call(1, 2, 3).to_s
+`, "[1, 2]"},
+		{`[1, 2, 3].map { |x| x * 10 }.to_s`, "[10, 20, 30]"},
+		{`[1, 2, 3].map(&-> (x) { x + 1 }).to_s`, "[2, 3, 4]"},
+		{`-> { 1 }.lambda?`, true},
+		{`lambda { 1 }.lambda?`, true},
+		{`proc { 1 }.lambda?`, false},
+		{`Block.new { 1 }.lambda?`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestLambdaAndProcFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`-> (x) { x }.call`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`-> (x) { x }.call(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`lambda { 1 }.call(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`lambda`, "ArgumentError: Can't initialize lambda without block argument", 1},
+		{`proc`, "ArgumentError: Can't initialize proc without block argument", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
@@ -194,6 +194,16 @@ func TestBooleanAssignmentByOperation(t *testing.T) {
 		{`a = false; a ||= nil;       a;`, nil},
 		{`a = false; a ||= nil || 1;  a;`, 1},
 		{`a = false; a ||= 1 || nil;  a;`, 1},
+		{`a = nil;   a ||= 5;         a;`, 5},
+		{`a ||= 5;   a;`, 5},
+		{`a = true;  a &&= 123;       a;`, 123},
+		{`a = 0;     a &&= "string";  a;`, "string"},
+		{`a = false; a &&= 123;       a;`, false},
+		{`a = nil;   a &&= 123;       a;`, nil},
+		{`@a = nil;  @a ||= 1;        @a;`, 1},
+		{`@a = 2;    @a ||= 1;        @a;`, 2},
+		{`@a = 2;    @a &&= 3;        @a;`, 3},
+		{`@a &&= 3;  @a;`, nil},
 	}
 
 	for i, tt := range tests {
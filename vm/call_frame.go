@@ -4,9 +4,11 @@ import (
 	"sync"
 )
 
+// callFrameStack is locked on every access, because errors raised on other threads read the main thread's call frames
 type callFrameStack struct {
 	callFrames []callFrame
 	pointer    int
+	sync.RWMutex
 }
 
 type baseFrame struct {
@@ -66,6 +68,8 @@ type normalCallFrame struct {
 	instructionSet *instructionSet
 	// program counter
 	pc int
+	// the value given by `break` when it's a block frame
+	breakValue Object
 }
 
 func (n *normalCallFrame) instructionsCount() int {
@@ -236,6 +240,9 @@ func (cfs *callFrameStack) push(cf callFrame) {
 		panic("Callframe can't be nil!")
 	}
 
+	cfs.Lock()
+	defer cfs.Unlock()
+
 	if len(cfs.callFrames) <= cfs.pointer {
 		cfs.callFrames = append(cfs.callFrames, cf)
 	} else {
@@ -248,6 +255,9 @@ func (cfs *callFrameStack) push(cf callFrame) {
 func (cfs *callFrameStack) pop() callFrame {
 	var cf callFrame
 
+	cfs.Lock()
+	defer cfs.Unlock()
+
 	if len(cfs.callFrames) < 1 {
 		panic("Nothing to pop!")
 	}
@@ -263,6 +273,9 @@ func (cfs *callFrameStack) pop() callFrame {
 }
 
 func (cfs *callFrameStack) top() callFrame {
+	cfs.RLock()
+	defer cfs.RUnlock()
+
 	if cfs.pointer > 0 {
 		return cfs.callFrames[cfs.pointer-1]
 	}
@@ -270,6 +283,19 @@ func (cfs *callFrameStack) top() callFrame {
 	return nil
 }
 
+// caller returns the call frame under the top one, which called the method running on the top frame.
+// It returns nil if there's no such frame.
+func (cfs *callFrameStack) caller() callFrame {
+	cfs.RLock()
+	defer cfs.RUnlock()
+
+	if cfs.pointer > 1 {
+		return cfs.callFrames[cfs.pointer-2]
+	}
+
+	return nil
+}
+
 func newNormalCallFrame(is *instructionSet, filename string, sourceLine int) *normalCallFrame {
 	return &normalCallFrame{baseFrame: &baseFrame{locals: make([]*Pointer, 5), lPr: 0, fileName: filename, sourceLine: sourceLine}, instructionSet: is, pc: 0}
 }
@@ -14,7 +14,10 @@ import (
 // You should always use channel objects for safe communications between threads.
 // `Channel#new` is available.
 //
+// `Channel.new(capacity)` creates a buffered channel, which is handy for the producer/consumer pattern.
+//
 // Note that channels are not like files and you don't need to explicitly close them (e.g.: exiting a loop).
+// Closing a channel is only needed to tell receivers that no more objects will be sent: `receive` returns nil after that.
 // See https://tour.golang.org/concurrency/4
 //
 // ```ruby
@@ -66,6 +69,12 @@ type ChannelObject struct {
 	*BaseObj
 	Chan         chan int
 	ChannelState int
+	// lock guards ChannelState, so no delivery starts after the channel is closed
+	lock sync.RWMutex
+	// done is closed by `close` to abort the ongoing deliveries
+	done chan struct{}
+	// delivering counts the ongoing deliveries, which `close` waits for before closing Chan
+	delivering sync.WaitGroup
 }
 
 // Channel's state.
@@ -83,17 +92,42 @@ const (
 // Class methods --------------------------------------------------------
 var builtinChannelClassMethods = []*BuiltinMethodObject{
 	{
-		// Creates an instance of `Channel` class, taking no arguments.
+		// Creates an instance of `Channel` class.
+		// If a capacity is given, the channel is buffered and `deliver` doesn't suspend the process
+		// until the buffer is full. Otherwise `deliver` suspends until the object is received.
 		//
 		// ```ruby
 		// c = Channel.new
 		// c.class         #=> Channel
+		//
+		// c = Channel.new(2)
+		// c.deliver(1)    # doesn't suspend
+		// c.deliver(2)    # doesn't suspend
+		// c.receive       #=> 1
 		// ```
 		//
+		// @param capacity [Integer]
 		// @return [Channel]
 		Name: "new",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			c := &ChannelObject{BaseObj: &BaseObj{class: t.vm.TopLevelClass(classes.ChannelClass)}, Chan: make(chan int, chOpen)}
+			var capacity int
+
+			switch len(args) {
+			case 0:
+			case 1:
+				i, ok := args[0].(*IntegerObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+				}
+				if i.value < 0 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.NegativeValue, i.value)
+				}
+				capacity = i.value
+			default:
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, len(args))
+			}
+
+			c := &ChannelObject{BaseObj: &BaseObj{class: t.vm.TopLevelClass(classes.ChannelClass)}, Chan: make(chan int, capacity), done: make(chan struct{})}
 			return c
 		},
 	},
@@ -101,6 +135,30 @@ var builtinChannelClassMethods = []*BuiltinMethodObject{
 
 // Instance methods -----------------------------------------------------
 var builtinChannelInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Sends an object to the receiver (channel) like `deliver`, but returns the channel itself
+		// so the calls can be chained.
+		//
+		// ```ruby
+		// c = Channel.new(2)
+		// c << 1 << 2
+		// c.receive        #=> 1
+		// c.receive        #=> 2
+		// ```
+		//
+		// @param object [Object]
+		// @return [Channel]
+		Name: "<<",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			c := receiver.(*ChannelObject)
+
+			if err, ok := c.deliver(t, args, sourceLine).(*Error); ok {
+				return err
+			}
+
+			return c
+		},
+	},
 	{
 		// Just to close and the channel to declare no more objects will be sent.
 		// Channel is not like files, and you don't need to call `close` explicitly unless
@@ -129,6 +187,7 @@ var builtinChannelInstanceMethods = []*BuiltinMethodObject{
 		// ```
 		//
 		// If you call `close` twice against the same channel, an error is returned.
+		// The `deliver` calls suspended on the channel are stopped, and they return ChannelCloseError.
 		//
 		// It takes no argument.
 		//
@@ -140,20 +199,26 @@ var builtinChannelInstanceMethods = []*BuiltinMethodObject{
 			}
 
 			c := receiver.(*ChannelObject)
+			c.lock.Lock()
 
 			if c.ChannelState == chClosed {
+				c.lock.Unlock()
 				return t.vm.InitErrorObject(errors.ChannelCloseError, sourceLine, errors.ChannelIsClosed)
 			}
 			c.ChannelState = chClosed
+			close(c.done)
+			c.lock.Unlock()
 
-			close(receiver.(*ChannelObject).Chan)
-			receiver = nil
+			// Sending to a closed channel panics, so wait for the deliveries to return first
+			c.delivering.Wait()
+			close(c.Chan)
 			return NULL
 		},
 	},
 	{
 		// Sends an object to the receiver (channel), then returns the object.
-		// Note that the method suspends the process until the object is actually received.
+		// Note that the method suspends the process until the object is actually received,
+		// or until the buffer has a room if the channel is buffered.
 		// Thus if you call `deliver` outside thread, the main process would suspend.
 		// Note that you don't need to send dummy object just to resume; use `close` instead.
 		//
@@ -169,7 +234,8 @@ var builtinChannelInstanceMethods = []*BuiltinMethodObject{
 		// c.receive        # receives `i`
 		// ```
 		//
-		// If you call `deliver` against the closed channel, an error is returned.
+		// If you call `deliver` against the closed channel, or the channel is closed while `deliver` is suspended,
+		// an error is returned.
 		//
 		// It takes 1 argument.
 		//
@@ -177,20 +243,7 @@ var builtinChannelInstanceMethods = []*BuiltinMethodObject{
 		// @return [Object]
 		Name: "deliver",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 1 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
-			}
-
-			c := receiver.(*ChannelObject)
-
-			if c.ChannelState == chClosed {
-				return t.vm.InitErrorObject(errors.ChannelCloseError, sourceLine, errors.ChannelIsClosed)
-			}
-
-			id := t.vm.channelObjectMap.storeObj(args[0])
-			c.Chan <- id
-
-			return args[0]
+			return receiver.(*ChannelObject).deliver(t, args, sourceLine)
 		},
 	},
 	{
@@ -209,7 +262,15 @@ var builtinChannelInstanceMethods = []*BuiltinMethodObject{
 		// end
 		// ```
 		//
-		// If you call `receive` against the closed channel, an error is returned.
+		// Once the channel is closed, `receive` returns the objects left in the buffer, and then returns nil.
+		//
+		// ```ruby
+		// c = Channel.new(1)
+		// c.deliver(1)
+		// c.close
+		// c.receive        #=> 1
+		// c.receive        #=> nil
+		// ```
 		//
 		// It takes no arguments.
 		//
@@ -220,14 +281,11 @@ var builtinChannelInstanceMethods = []*BuiltinMethodObject{
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
 
-			c := receiver.(*ChannelObject)
-
-			if c.ChannelState == chClosed {
-				return t.vm.InitErrorObject(errors.ChannelCloseError, sourceLine, errors.ChannelIsClosed)
+			num, ok := <-receiver.(*ChannelObject).Chan
+			if !ok {
+				return NULL
 			}
 
-			num := <-c.Chan
-
 			return t.vm.channelObjectMap.retrieveObj(num)
 		},
 	},
@@ -268,10 +326,36 @@ func (co *ChannelObject) ToJSON(t *Thread) string {
 
 // copy returns the duplicate of the Array object
 func (co *ChannelObject) copy() Object {
-	newC := &ChannelObject{BaseObj: &BaseObj{class: co.class}, Chan: make(chan int)}
+	newC := &ChannelObject{BaseObj: &BaseObj{class: co.class}, Chan: make(chan int, cap(co.Chan)), done: make(chan struct{})}
 	return newC
 }
 
+// deliver sends the object to the channel; common to `deliver` and `<<`
+func (co *ChannelObject) deliver(t *Thread, args []Object, sourceLine int) Object {
+	if len(args) != 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+	}
+
+	co.lock.RLock()
+	if co.ChannelState == chClosed {
+		co.lock.RUnlock()
+		return t.vm.InitErrorObject(errors.ChannelCloseError, sourceLine, errors.ChannelIsClosed)
+	}
+	co.delivering.Add(1)
+	co.lock.RUnlock()
+
+	defer co.delivering.Done()
+
+	id := t.vm.channelObjectMap.storeObj(args[0])
+
+	select {
+	case co.Chan <- id:
+		return args[0]
+	case <-co.done:
+		return t.vm.InitErrorObject(errors.ChannelCloseError, sourceLine, errors.ChannelIsClosed)
+	}
+}
+
 // objectMap ==========================================================
 
 type objectMap struct {
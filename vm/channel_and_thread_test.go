@@ -141,3 +141,197 @@ func TestObjectDeliveryBetweenThread(t *testing.T) {
 		v.checkSP(t, i, 1)
 	}
 }
+
+func TestThreadJoinMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		t = thread do
+		  1 + 1
+		end
+		t.join
+		`, 2},
+		{`
+		t = Thread.new(20) do |n|
+		  n * 2
+		end
+		t.join
+		`, 40},
+		{`
+		t = Thread.new(1, 2) do |a, b|
+		  [a, b]
+		end
+		t.value
+		`, []interface{}{1, 2}},
+		{`
+		t = thread do
+		  "Goby"
+		end
+		t.join
+		t.join
+		`, "Goby"},
+		{`
+		t = thread do
+		end
+		t.join
+		`, nil},
+		{`
+		t = thread do
+		  1
+		end
+		t.class.name
+		`, "Thread"},
+		{`
+		t = thread do
+		  1
+		end
+		t.join
+		t.alive?
+		`, false},
+		{`
+		c = Channel.new
+		t = thread do
+		  c.receive
+		end
+		alive = t.alive?
+		c.deliver(1)
+		t.join
+		alive
+		`, true},
+		{`
+		module Foo
+		  class Bar
+		    def self.baz
+		      10
+		    end
+		  end
+		end
+
+		t = thread do
+		  Foo::Bar.baz
+		end
+		t.join
+		`, 10},
+		{`
+		t = thread do
+		  1 / 0
+		end
+		while t.alive? do
+		end
+		a = 10
+		a + 1
+		`, 11},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestThreadPartialSums(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		threads = []
+		4.times do |i|
+		  t = Thread.new(i) do |n|
+		    sum = 0
+		    ((n * 25 + 1)..(n * 25 + 25)).each do |x|
+		      sum += x
+		    end
+		    sum
+		  end
+		  threads.push(t)
+		end
+
+		sums = threads.map do |t|
+		  t.join
+		end
+		sums.reduce(0) do |total, sum|
+		  total + sum
+		end
+		`, 5050},
+		{`
+		threads = []
+		4.times do |i|
+		  t = Thread.new(i) do |n|
+		    sum = 0
+		    ((n * 25 + 1)..(n * 25 + 25)).each do |x|
+		      sum += x
+		    end
+		    sum
+		  end
+		  threads.push(t)
+		end
+
+		threads.map do |t|
+		  t.join
+		end
+		`, []interface{}{325, 950, 1575, 2200}},
+		{`
+		a = []
+		b = []
+		t1 = thread do
+		  100.times do |i|
+		    a.push(i)
+		  end
+		end
+		t2 = thread do
+		  100.times do |i|
+		    b.push(i * 2)
+		  end
+		end
+		t1.join
+		t2.join
+		[a.length, b.length, a.last, b.last]
+		`, []interface{}{100, 100, 99, 198}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestThreadMethodsFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Thread.new`, "InternalError: Can't yield without a block", 1},
+		{`
+		t = thread do
+		  1 / 0
+		end
+		t.join
+		`, "ZeroDivisionError: Divided by 0", 1},
+		{`
+		t = thread do
+		  1
+		end
+		t.join(1)
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`
+		t = thread do
+		  1
+		end
+		t.alive?(1)
+		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
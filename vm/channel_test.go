@@ -20,6 +20,195 @@ func TestChannelClassSuperclass(t *testing.T) {
 	}
 }
 
+func TestChannelNewMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Channel.new.class.name`, "Channel"},
+		{`
+		c = Channel.new(2)
+		c.deliver(1)
+		c.deliver(2)
+		c.receive + c.receive
+		`, 3},
+		{`
+		c = Channel.new(0)
+		thread do
+		  c.deliver(1)
+		end
+		c.receive
+		`, 1},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestChannelNewFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Channel.new("a")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`Channel.new(-1)`, "ArgumentError: Expect argument to be positive value. got: -1", 1},
+		{`Channel.new(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestChannelDeliverOperator(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		c = Channel.new
+		thread do
+		  c << 42
+		end
+		c.receive
+		`, 42},
+		{`
+		c = Channel.new(2)
+		c << "a" << "b"
+		c.receive + c.receive
+		`, "ab"},
+		{`
+		c = Channel.new(1)
+		(c << 1).class.name
+		`, "Channel"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestChannelProducerConsumer(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		jobs = Channel.new(5)
+		results = Channel.new(5)
+
+		workers = []
+		3.times do
+		  w = thread do
+		    n = jobs.receive
+		    while n do
+		      results << n * n
+		      n = jobs.receive
+		    end
+		  end
+		  workers.push(w)
+		end
+
+		producer = thread do
+		  i = 1
+		  while i <= 10 do
+		    jobs << i
+		    i += 1
+		  end
+		  jobs.close
+		end
+
+		sum = 0
+		10.times do
+		  sum += results.receive
+		end
+		producer.join
+		workers.each do |w|
+		  w.join
+		end
+		sum
+		`, 385},
+		{`
+		c = Channel.new(3)
+		producer = thread do
+		  [1, 2, 3, 4, 5].each do |i|
+		    c << i
+		  end
+		  c.close
+		end
+
+		received = []
+		v = c.receive
+		while v do
+		  received.push(v)
+		  v = c.receive
+		end
+		producer.join
+		received
+		`, []interface{}{1, 2, 3, 4, 5}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestChannelReceiveAfterClose(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		c = Channel.new
+		c.close
+		c.receive
+		`, nil},
+		{`
+		c = Channel.new(2)
+		c.deliver(1)
+		c.close
+		c.receive
+		`, 1},
+		{`
+		c = Channel.new(2)
+		c.deliver(1)
+		c.close
+		c.receive
+		c.receive
+		`, nil},
+		{`
+		c = Channel.new
+		t = thread do
+		  c.receive
+		end
+		c.close
+		t.join
+		`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestChannelCloseFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`c = Channel.new; c.close(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
@@ -38,7 +227,6 @@ func TestChannelCloseFail(t *testing.T) {
 func TestChannelReceiveFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`c = Channel.new; c.receive(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
-		{`c = Channel.new; c.close; c.receive`, "ChannelCloseError: The channel is already closed.", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -55,6 +243,17 @@ func TestChannelDeliverFail(t *testing.T) {
 		{`c = Channel.new; c.deliver`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
 		{`c = Channel.new; c.deliver 1, 2`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
 		{`c = Channel.new; c.close; c.deliver 1`, "ChannelCloseError: The channel is already closed.", 1},
+		{`c = Channel.new; c.send("<<")`, "ArgumentError: Expect 1 argument(s). got: 0", 2},
+		{`c = Channel.new; c.close; c << 1`, "ChannelCloseError: The channel is already closed.", 1},
+		{`
+		c = Channel.new
+		t = thread do
+		  c.deliver(1)
+		end
+		sleep(0.1)
+		c.close
+		t.join
+		`, "ChannelCloseError: The channel is already closed.", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 	"path"
-	"reflect"
 	"sync"
 	"time"
 
@@ -688,20 +687,13 @@ var builtinModuleCommonClassMethods = []*BuiltinMethodObject{
 		// end
 		// puts(Foo.superclass)    # => <Class:Object>
 		// puts(Bar.superclass)    # => <Class:Foo>
+		// Object.superclass       # => nil
 		// ```
 		//
-		// **Note**: the following is not supported:
-		//
-		// - Class class
-		//
-		// - Object class
-		//
-		// - instance objects or object literals
+		// **Note**: instance objects or object literals are not supported:
 		//
 		// ```ruby
 		// puts("string".superclass) # => error
-		// puts(Class.superclass)    # => error
-		// puts(Object.superclass)   # => error
 		// ```
 		// @param class [Class] Receiver
 		// @return [Object] Superclass object of the receiver
@@ -719,7 +711,8 @@ var builtinModuleCommonClassMethods = []*BuiltinMethodObject{
 
 			superClass := c.returnSuperClass()
 
-			if superClass == nil {
+			// Object is the root class, so it points to itself internally
+			if superClass == nil || superClass == c {
 				return NULL
 			}
 
@@ -756,7 +749,7 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 			className := receiver.Class().Name
 			compareClassName := args[0].Class().Name
 
-			if className == compareClassName && reflect.DeepEqual(receiver, args[0]) {
+			if className == compareClassName && deepEqual(receiver, args[0]) {
 				return TRUE
 			}
 			return FALSE
@@ -815,12 +808,37 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 			className := receiver.Class().Name
 			compareClassName := args[0].Class().Name
 
-			if className == compareClassName && reflect.DeepEqual(receiver, args[0]) {
+			if className == compareClassName && deepEqual(receiver, args[0]) {
 				return FALSE
 			}
 			return TRUE
 		},
 	},
+	{
+		// Returns the path of the file that is currently running.
+		// In the script passed to the interpreter, it's the script's absolute path.
+		//
+		// ```ruby
+		// # goby /tmp/hello.gb
+		// __FILE__ # => "/tmp/hello.gb"
+		// ```
+		//
+		// @return [String]
+		Name: "__FILE__",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			cf := t.callFrameStack.caller()
+			if cf == nil {
+				return NULL
+			}
+
+			return t.vm.InitStringObject(cf.FileName())
+
+		},
+	},
 	{
 		// Returns true if a block is given in the current context and `yield` is ready to call.
 		//
@@ -874,6 +892,30 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns a shallow copy of the receiver with its instance variables, singleton methods and frozen state.
+		// The copy is always a new object, except for `nil`, `true` and `false`, which have only one object each.
+		// The elements of a copied Array or Hash are shared with the receiver.
+		//
+		// ```ruby
+		// a = [[1], 2]
+		// b = a.clone
+		// b.push(3)
+		// b[0].push(4)
+		// a # => [[1, 4], 2]
+		// ```
+		//
+		// @return [Object]
+		Name: "clone",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			return t.vm.cloneObject(receiver, sourceLine)
+
+		},
+	},
 	{
 		Name: "dup",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
@@ -922,6 +964,70 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Same as `sprintf`.
+		//
+		// @param format [String], *args [Object]
+		// @return [String]
+		Name: "format",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return sprintf(t, args, sourceLine)
+
+		},
+	},
+	{
+		// Prevents further modifications to the receiver and returns the receiver.
+		// Modifying a frozen Array, Hash or String raises a FrozenError.
+		// A frozen object can't be unfrozen, but its `dup` is not frozen.
+		//
+		// ```ruby
+		// a = [1, 2].freeze
+		// a.frozen?     # => true
+		// a.push(3)     # => FrozenError: Can't modify frozen Array: [1, 2]
+		// a.dup.push(3) # => [1, 2, 3]
+		// ```
+		//
+		// @return [Object]
+		Name: "freeze",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			receiver.freeze()
+			return receiver
+
+		},
+	},
+	{
+		// Returns true if the receiver is frozen.
+		// Immutable objects like Integers, Floats, Decimals, Booleans and `nil` are always frozen,
+		// and so are the strings from symbol literals.
+		//
+		// ```ruby
+		// [1, 2].frozen?        # => false
+		// [1, 2].freeze.frozen? # => true
+		// 1.frozen?             # => true
+		// nil.frozen?           # => true
+		// :foo.frozen?          # => true
+		// ```
+		//
+		// @return [Boolean]
+		Name: "frozen?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			switch receiver.(type) {
+			case *IntegerObject, *FloatObject, *DecimalObject, *BooleanObject, *NullObject:
+				return TRUE
+			}
+
+			return toBooleanObject(receiver.isFrozen())
+
+		},
+	},
 	{
 		// Returns true if Object class is equal to the input argument class
 		//
@@ -1106,6 +1212,35 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns true if the input argument class is exactly the Object's class.
+		// Unlike `is_a?`, superclasses and included modules are not matched.
+		//
+		// ```ruby
+		// "Hello".instance_of?(String) # => true
+		// "Hello".instance_of?(Object) # => false
+		// 123.instance_of?(Integer)    # => true
+		// nil.instance_of?(Null)       # => true
+		// ```
+		//
+		// @param class [Class]
+		// @return [Boolean]
+		Name: "instance_of?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			gobyClass, ok := args[0].(*RClass)
+
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.ClassClass, args[0].Class().Name)
+			}
+
+			return toBooleanObject(receiver.Class() == gobyClass)
+
+		},
+	},
 	// Returns the value of the instance variable.
 	// Only string literal with `@` is supported.
 	//
@@ -1174,12 +1309,46 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
 			}
 
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			receiver.InstanceVariableSet(argName.value, obj)
 
 			return obj
 
 		},
 	},
+	{
+		// Returns a lambda from the given block. A lambda is a block object that checks the number of its arguments.
+		// It can also be created with `->`.
+		//
+		// ```ruby
+		// add = lambda do |x, y|
+		//   x + y
+		// end
+		// add.call(1, 2)    # => 3
+		// add.call(1)       # => ArgumentError
+		//
+		// double = -> (x) { x * 2 }
+		// double.(4)        # => 8
+		// ```
+		//
+		// @param block literal
+		// @return [Block]
+		Name: "lambda",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Can't initialize lambda without block argument")
+			}
+
+			b := t.vm.initBlockObject(blockFrame.instructionSet, blockFrame.ep, blockFrame.self)
+			b.lambda = true
+
+			return b
+
+		},
+	},
 	// Returns an array that contains the method names of the receiver.
 	//
 	// ```ruby
@@ -1242,6 +1411,37 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Prints the inspected representation of each argument with a tailing line feed, and returns
+		// the argument. Multiple arguments are returned as an array.
+		//
+		// ```ruby
+		// p("foo", 1)
+		// # => "foo"
+		// # => 1
+		// a = p([1, "a"]) # => [1, "a"]
+		// a               # => [1, "a"]
+		// ```
+		//
+		// @param *args [Object]
+		// @return [Object]
+		Name: "p",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			for _, arg := range args {
+				fmt.Fprintln(t.vm.output, arg.Inspect())
+			}
+
+			switch len(args) {
+			case 0:
+				return NULL
+			case 1:
+				return args[0]
+			default:
+				return t.vm.InitArrayObject(args)
+			}
+
+		},
+	},
 	{
 		// Print an object, without the newline, converting into String if needed.
 		//
@@ -1255,16 +1455,39 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 		Name: "print",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			for _, arg := range args {
-				fmt.Print(arg.ToString())
+				fmt.Fprint(t.vm.output, arg.ToString())
 			}
 
 			return NULL
 
 		},
 	},
+	{
+		// Returns a block object from the given block, just like `Block.new`.
+		//
+		// ```ruby
+		// add = proc do |x, y|
+		//   x + y
+		// end
+		// add.call(1, 2)    # => 3
+		// add.call(1, 2, 3) # => 3
+		// ```
+		//
+		// @param block literal
+		// @return [Block]
+		Name: "proc",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Can't initialize proc without block argument")
+			}
+
+			return t.vm.initBlockObject(blockFrame.instructionSet, blockFrame.ep, blockFrame.self)
+
+		},
+	},
 	{
 		// Puts string literals or objects into stdout with a tailing line feed, converting into String
-		// if needed.
+		// if needed. Elements of an array are put one per line.
 		//
 		// ```ruby
 		// puts("foo", "bar")
@@ -1275,6 +1498,9 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 		// # => String
 		// puts("foo" + "bar")
 		// # => foobar
+		// puts([1, 2])
+		// # => 1
+		// # => 2
 		// ```
 		// TODO: interpolation is needed to be implemented.
 		//
@@ -1282,8 +1508,12 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 		// @return [Null]
 		Name: "puts",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) == 0 {
+				fmt.Fprintln(t.vm.output)
+			}
+
 			for _, arg := range args {
-				fmt.Println(arg.ToString())
+				putsObject(t.vm.output, arg)
 			}
 
 			return NULL
@@ -1291,14 +1521,39 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
+		// Raises an error, which stops the program and prints the message with the stack traces.
+		// A message alone raises a `RuntimeError`, and a class alone raises the class with its name as the message.
+		// Without arguments, a `RuntimeError` with "unhandled exception" is raised.
+		// The class should be `Exception` or its subclass, or a `TypeError` is raised.
+		//
+		// ```ruby
+		// raise "boom"                  # => RuntimeError: 'boom'
+		// raise ArgumentError, "bad"    # => ArgumentError: 'bad'
+		// raise ArgumentError           # => ArgumentError: 'ArgumentError'
+		// raise                         # => RuntimeError: unhandled exception
+		// ```
+		//
+		// @param error class [Class], message [String]
+		// @return [Error]
 		Name: "raise",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			aLen := len(args)
 			switch aLen {
 			case 0:
-				return t.vm.InitErrorObject(errors.InternalError, sourceLine, "")
+				return t.vm.InitErrorObject(errors.RuntimeError, sourceLine, errors.UnhandledException)
 			case 1:
-				return t.vm.InitErrorObject(errors.InternalError, sourceLine, "'%s'", args[0].ToString())
+				switch arg := args[0].(type) {
+				case *RClass:
+					if !arg.isErrorClass() {
+						return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.NotExceptionClass, arg.Name)
+					}
+
+					return t.vm.initRaisedError(arg, sourceLine, arg.Name)
+				case *StringObject:
+					return t.vm.initRaisedError(t.vm.objectClass.getClassConstant(errors.RuntimeError), sourceLine, arg.value)
+				default:
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.ExceptionExpected, arg.Class().Name)
+				}
 			case 2:
 				errorClass, ok := args[0].(*RClass)
 
@@ -1306,7 +1561,11 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, "a class", args[0].Class().Name)
 				}
 
-				return t.vm.InitErrorObject(errorClass.Name, sourceLine, "'%s'", args[1].ToString())
+				if !errorClass.isErrorClass() {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.NotExceptionClass, errorClass.Name)
+				}
+
+				return t.vm.initRaisedError(errorClass, sourceLine, args[1].ToString())
 			}
 
 			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 2, aLen)
@@ -1551,6 +1810,39 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
+		// Returns the string formatted with the arguments as a printf-style format string.
+		// Supports the `%d`, `%i`, `%s`, `%f`, `%e`, `%g`, `%x`, `%X`, `%o` and `%b` formats with the
+		// `-`, `+`, ` `, `0` and `#` flags, width and precision. `%%` is a literal percent sign.
+		//
+		// ```ruby
+		// sprintf("%05d", 42)                # => "00042"
+		// sprintf("%-5s|", "ab")             # => "ab   |"
+		// sprintf("%.2f", 3.14159)           # => "3.14"
+		// sprintf("%x %b", 255, 5)           # => "ff 101"
+		// ```
+		//
+		// @param format [String], *args [Object]
+		// @return [String]
+		Name: "sprintf",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return sprintf(t, args, sourceLine)
+
+		},
+	},
+	{
+		// Yields the receiver to the block and returns the receiver.
+		// Useful for inspecting intermediate values in a method chain.
+		//
+		// ```ruby
+		// [1, 2, 3].tap do |a|
+		//   puts(a.length)   # => 3
+		// end.map do |i|
+		//   i * 2
+		// end                # => [2, 4, 6]
+		// ```
+		//
+		// @param block
+		// @return [Object] receiver
 		Name: "tap",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			if blockFrame == nil {
@@ -1563,23 +1855,53 @@ var builtinClassCommonInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
+		// Yields the receiver to the block and returns the result of the block.
+		//
+		// ```ruby
+		// 3.then do |i|
+		//   i * 2
+		// end   # => 6
+		// ```
+		//
+		// @param block
+		// @return [Object] result of the block
+		Name: "then",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return yieldSelf(receiver, sourceLine, t, blockFrame)
+
+		},
+	},
+	{
+		// Runs the block on a new thread with the given arguments, and returns the thread.
+		// Same as `Thread.new`.
+		//
+		// ```ruby
+		// t = thread do
+		//   1 + 1
+		// end
+		// t.join # => 2
+		// ```
+		//
+		// @param *args [Object], block literal
+		// @return [Thread]
 		Name: "thread",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			if blockFrame == nil {
 				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
 			}
 
-			newT := t.vm.newThread()
+			return t.spawn(blockFrame, args)
 
-			go func() {
-				newT.builtinMethodYield(blockFrame, args...)
-			}()
-
-			// We need to pop this frame from main thread manually,
-			// because the block's 'leave' instruction is running on other process
-			t.callFrameStack.pop()
-
-			return NULL
+		},
+	},
+	{
+		// Same as `then`.
+		//
+		// @param block
+		// @return [Object] result of the block
+		Name: "yield_self",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return yieldSelf(receiver, sourceLine, t, blockFrame)
 
 		},
 	},
@@ -1869,6 +2191,17 @@ func (c *RClass) alreadyInherit(constant *RClass) bool {
 	return c.superClass.alreadyInherit(constant)
 }
 
+// isErrorClass returns true if the class is Exception or its subclass
+func (c *RClass) isErrorClass() bool {
+	for _, klass := range c.ancestors() {
+		if klass.Name == errors.Exception {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *RClass) returnSuperClass() *RClass {
 	return c.pseudoSuperClass
 }
@@ -1932,10 +2265,91 @@ func (c *RClass) ancestors() []*RClass {
 
 // Other helper functions -----------------------------------------------
 
+// cloneObject copies the object by its type for `clone`, so the builtin `dup` overridden by the class is never used
+func (vm *VM) cloneObject(receiver Object, sourceLine int) Object {
+	var copied Object
+
+	switch r := receiver.(type) {
+	case *NullObject, *BooleanObject:
+		return receiver
+	case *RObject:
+		copied = r.Class().initializeInstance()
+	case *StringObject:
+		copied = vm.InitStringObject(r.value)
+	case *IntegerObject:
+		copied = vm.InitIntegerObject(r.value)
+	case *FloatObject:
+		copied = vm.initFloatObject(r.value)
+	case *DecimalObject:
+		copied = vm.initDecimalObject(new(Decimal).Set(r.value))
+	case *RangeObject:
+		copied = vm.initRangeObject(r.Start, r.End, r.Exclusive)
+	case *TimeObject:
+		copied = vm.initTimeObject(r.value)
+	case *ArrayObject:
+		copied = r.copy()
+	case *HashObject:
+		copied = r.copy()
+	default:
+		return vm.InitErrorObject(errors.TypeError, sourceLine, errors.CantClone, receiver.Class().Name)
+	}
+
+	if ivars := receiver.instanceVariables(); ivars != nil {
+		copied.setInstanceVariables(ivars.copy())
+	}
+
+	// The singleton methods are copied too, and defining one on the clone doesn't affect the receiver
+	if sc := receiver.SingletonClass(); sc != nil {
+		copiedSingleton := vm.createRClass(fmt.Sprintf("#<Class:#<%s:%d>>", copied.Class().Name, copied.id()))
+		copiedSingleton.Methods = sc.Methods.copy()
+		copiedSingleton.isSingleton = true
+		copied.SetSingletonClass(copiedSingleton)
+	}
+
+	if receiver.isFrozen() {
+		copied.freeze()
+	}
+
+	return copied
+}
+
+// yieldSelf yields the receiver to the block and returns the result of the block; common to `then` and `yield_self`.
+func yieldSelf(receiver Object, sourceLine int, t *Thread, blockFrame *normalCallFrame) Object {
+	if blockFrame == nil {
+		return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+	}
+
+	if blockIsEmpty(blockFrame) {
+		t.callFrameStack.pop()
+		return NULL
+	}
+
+	return t.builtinMethodYield(blockFrame, receiver).Target
+}
+
+// sprintf formats the arguments with the format string given as the first argument; common to `format` and `sprintf`.
+func sprintf(t *Thread, args []Object, sourceLine int) Object {
+	if len(args) < 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentMore, 1, len(args))
+	}
+
+	format, ok := args[0].(*StringObject)
+
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+	}
+
+	return format.format(t, args[1:], sourceLine)
+}
+
 func generateAttrWriteMethod(attrName string) *BuiltinMethodObject {
 	return &BuiltinMethodObject{
 		Name: attrName + "=",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			v := receiver.InstanceVariableSet("@"+attrName, args[0])
 			return v
 		},
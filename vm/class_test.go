@@ -1067,7 +1067,7 @@ func TestInspectMethod(t *testing.T) {
 		   @bar = { float: 2.71, decimal: 3.14.to_d }
 		 end
 		end
-		Foo.new.inspect`, `#<Foo:##OBJECTID## @bar={ decimal: 3.14, float: 2.71 } @foo=[42, "string", { key: "value" }] >`, 1},
+		Foo.new.inspect`, `#<Foo:##OBJECTID## @bar={ float: 2.71, decimal: 3.14 } @foo=[42, "string", { key: "value" }] >`, 1},
 	}
 
 	for i, tt := range tests {
@@ -1086,13 +1086,25 @@ func TestRaiseMethod(t *testing.T) {
 		expectedCFP int
 		expectedSP  int
 	}{
-		{`raise`, "InternalError: ", 1, 1},
-		{`raise "Foo"`, "InternalError: 'Foo'", 1, 1},
+		{`raise`, "RuntimeError: unhandled exception", 1, 1},
+		{`raise "Foo"`, "RuntimeError: 'Foo'", 1, 1},
+		{`raise ArgumentError`, "ArgumentError: 'ArgumentError'", 1, 1},
+		{`raise ArgumentError, "bad"`, "ArgumentError: 'bad'", 1, 1},
 		{`
-		class BarError; end
+		class BarError < StandardError; end
 		raise BarError, "Foo"`, "BarError: 'Foo'", 1, 1},
 		{`
-		class FooError; end
+		module Foo
+		  class BarError < StandardError; end
+		end
+		raise Foo::BarError, "Foo"`, "BarError: 'Foo'", 1, 1},
+		{`
+		module Foo
+		  class BarError < StandardError; end
+		end
+		raise Foo::BarError`, "BarError: 'BarError'", 1, 1},
+		{`
+		class FooError < StandardError; end
 
 		def raise_foo
 		  raise FooError, "Foo"
@@ -1119,8 +1131,14 @@ func TestRaiseMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`raise "Foo", "Bar"`, "ArgumentError: Expect argument #2 to be a class. got: String", 1},
 		{`
-		class BarError; end
+		class BarError < StandardError; end
 		raise BarError, "Foo", "Bar"`, "ArgumentError: Expect 2 or less argument(s). got: 3", 1},
+		{`raise 10`, "TypeError: Expect an Exception class or a String. got: Integer", 1},
+		{`raise String`, "TypeError: Expect a subclass of Exception. got: String", 1},
+		{`raise String, "x"`, "TypeError: Expect a subclass of Exception. got: String", 1},
+		{`
+		class BarError; end
+		raise BarError, "Foo"`, "TypeError: Expect a subclass of Exception. got: BarError", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1240,6 +1258,48 @@ func TestRequireRelativeMethodFail(t *testing.T) {
 	}
 }
 
+func TestFileMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`__FILE__`, "/tmp/goby/main.gb"},
+		{`
+		def foo
+		  __FILE__
+		end
+		foo
+		`, "/tmp/goby/main.gb"},
+		{`
+		[1].map do |i|
+		  __FILE__
+		end.first
+		`, "/tmp/goby/main.gb"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, "/tmp/goby/main.gb")
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestFileMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`__FILE__(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestSendMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1355,6 +1415,41 @@ func TestExitMethodFail(t *testing.T) {
 	}
 }
 
+func TestGeneralClassMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"hi".class.name`, "String"},
+		{`"hi".class.to_s`, "String"},
+		{`"hi".class == String`, true},
+		{`[].class.name`, "Array"},
+		{`[].class == Array`, true},
+		{`{}.class.name`, "Hash"},
+		{`1.class.name`, "Integer"},
+		{`1.5.class.name`, "Float"},
+		{`nil.class.name`, "Null"},
+		{`true.class.name`, "Boolean"},
+		{`String.class.name`, "Class"},
+		{`1.class.superclass.name`, "Object"},
+		{`1.class.superclass.superclass`, nil},
+		{`
+		class Foo; end
+		class Bar < Foo; end
+
+		Bar.new.class.superclass.name
+		`, "Foo"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestGeneralIsAMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1391,6 +1486,21 @@ func TestGeneralIsAMethod(t *testing.T) {
 		{`nil.is_a?(Object)`, true},
 		{`nil.is_a?(String)`, false},
 		{`nil.is_a?(Range)`, false},
+		{`
+		module Baz; end
+		class Foo
+		  include Baz
+		end
+		class Bar < Foo; end
+
+		Bar.new.is_a?(Foo) && Bar.new.is_a?(Baz)
+		`, true},
+		{`
+		class Foo; end
+		class Bar < Foo; end
+
+		Foo.new.is_a?(Bar)
+		`, false},
 	}
 
 	for i, tt := range tests {
@@ -1487,6 +1597,65 @@ func TestGeneralKindOfMethodFail(t *testing.T) {
 	}
 }
 
+func TestGeneralInstanceOfMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{`123.instance_of?(Integer)`, true},
+		{`123.instance_of?(Object)`, false},
+		{`"Hello World".instance_of?(String)`, true},
+		{`"Hello World".instance_of?(Object)`, false},
+		{`[1, 2].instance_of?(Array)`, true},
+		{`nil.instance_of?(Null)`, true},
+		{`String.instance_of?(Class)`, true},
+		{`
+		class Foo; end
+		class Bar < Foo; end
+
+		Bar.new.instance_of?(Bar)
+		`, true},
+		{`
+		class Foo; end
+		class Bar < Foo; end
+
+		Bar.new.instance_of?(Foo)
+		`, false},
+		{`
+		module Baz; end
+		class Foo
+		  include Baz
+		end
+
+		Foo.new.instance_of?(Baz)
+		`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestGeneralInstanceOfMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`123.instance_of?`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`123.instance_of?(Integer, String)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`123.instance_of?(true)`, "TypeError: Expect argument to be Class. got: Boolean", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestGeneralIsNilMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1582,8 +1751,13 @@ func TestClassSuperclassClassMethod(t *testing.T) {
 		{`Range.superclass.name`, "Object"},
 		{`Hash.superclass.name`, "Object"},
 		{`Array.superclass.name`, "Object"},
-		{`Object.superclass.name`, "Object"},
+		{`Object.superclass`, nil},
 		{`Module.superclass.name`, "Object"},
+		{`
+		class Foo; end
+		class Bar < Foo; end
+		Bar.superclass.superclass.superclass
+		`, nil},
 		{`Class.superclass.name`, "Module"},
 
 		// This is to make sure superclass won't return included module
@@ -22,4 +22,10 @@ const (
 	GoMapClass     = "GoMap"
 	DecimalClass   = "Decimal"
 	BlockClass     = "Block"
+	TimeClass      = "Time"
+	RandomClass    = "Random"
+	StringIOClass  = "StringIO"
+	ThreadClass    = "Thread"
+	MutexClass     = "Mutex"
+	EnvClass       = "Env"
 )
@@ -0,0 +1,88 @@
+package vm
+
+import (
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// The comparison operators of the `Comparable` module; the rest of the module is written in Goby (lib/comparable.gb).
+// Each operator sends `<=>` to the receiver, and returns an ArgumentError if `<=>` returns nil.
+
+// Instance methods -----------------------------------------------------
+var builtinComparableInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns true if `<=>` returns a negative Integer.
+		//
+		// @param object [Object]
+		// @return [Boolean]
+		Name: "<",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return compareWithOperator(receiver, sourceLine, t, args, func(result int) bool { return result < 0 })
+
+		},
+	},
+	{
+		// Returns true if `<=>` returns a negative Integer or 0.
+		//
+		// @param object [Object]
+		// @return [Boolean]
+		Name: "<=",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return compareWithOperator(receiver, sourceLine, t, args, func(result int) bool { return result <= 0 })
+
+		},
+	},
+	{
+		// Returns true if `<=>` returns a positive Integer.
+		//
+		// @param object [Object]
+		// @return [Boolean]
+		Name: ">",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return compareWithOperator(receiver, sourceLine, t, args, func(result int) bool { return result > 0 })
+
+		},
+	},
+	{
+		// Returns true if `<=>` returns a positive Integer or 0.
+		//
+		// @param object [Object]
+		// @return [Boolean]
+		Name: ">=",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return compareWithOperator(receiver, sourceLine, t, args, func(result int) bool { return result >= 0 })
+
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initComparableModule() *RClass {
+	cm := vm.initializeModule("Comparable")
+	cm.setBuiltinMethods(builtinComparableInstanceMethods, false)
+	return cm
+}
+
+// Other helper functions -----------------------------------------------
+
+// compareWithOperator sends `<=>` to the receiver and checks its result with the operator
+func compareWithOperator(receiver Object, sourceLine int, t *Thread, args []Object, operator func(result int) bool) Object {
+	if len(args) != 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+	}
+
+	result := t.callMethod(receiver, "<=>", sourceLine, args[0])
+
+	if err, ok := result.(*Error); ok {
+		return err
+	}
+
+	i, ok := result.(*IntegerObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.ComparisonFailed, receiver.Class().Name, args[0].Class().Name)
+	}
+
+	return toBooleanObject(operator(i.value))
+}
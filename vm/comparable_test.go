@@ -0,0 +1,76 @@
+package vm
+
+import (
+	"testing"
+)
+
+const temperatureClass = `
+class Temperature
+  include Comparable
+
+  attr_reader :degrees
+
+  def initialize(degrees)
+    @degrees = degrees
+  end
+
+  def <=>(other)
+    if other.is_a?(Temperature)
+      @degrees <=> other.degrees
+    end
+  end
+end
+`
+
+func TestComparableOperators(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Temperature.new(10) < Temperature.new(20)`, true},
+		{`Temperature.new(20) < Temperature.new(20)`, false},
+		{`Temperature.new(20) <= Temperature.new(20)`, true},
+		{`Temperature.new(30) <= Temperature.new(20)`, false},
+		{`Temperature.new(30) > Temperature.new(20)`, true},
+		{`Temperature.new(20) > Temperature.new(20)`, false},
+		{`Temperature.new(20) >= Temperature.new(20)`, true},
+		{`Temperature.new(10) >= Temperature.new(20)`, false},
+		{`Temperature.new(20) == Temperature.new(20)`, true},
+		{`Temperature.new(10) == Temperature.new(20)`, false},
+		{`Temperature.new(10) == 10`, false},
+		{`Temperature.new(10).between?(Temperature.new(5), Temperature.new(20))`, true},
+		{`Temperature.new(5).between?(Temperature.new(5), Temperature.new(20))`, true},
+		{`Temperature.new(20).between?(Temperature.new(5), Temperature.new(20))`, true},
+		{`Temperature.new(30).between?(Temperature.new(5), Temperature.new(20))`, false},
+		{`Temperature.new(10).is_a?(Comparable)`, true},
+		{`Temperature.new(10).respond_to?(:__compare__)`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, temperatureClass+tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestComparableOperatorsFail(t *testing.T) {
+	testsFail := []struct {
+		input       string
+		expected    string
+		expectedCFP int
+		expectedSP  int
+	}{
+		{`Temperature.new(10) < 10`, "ArgumentError: Comparison of Temperature with Integer failed", 1, 1},
+		{`Temperature.new(10).between?(1, 20)`, "ArgumentError: Comparison of Temperature with Integer failed", 2, 4},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, temperatureClass+tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, tt.expectedSP)
+	}
+}
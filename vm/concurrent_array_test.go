@@ -276,7 +276,7 @@ func TestConcurrentArrayAnyMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`
 		require 'concurrent/array'
-		Concurrent::Array.new([]).any?`, "InternalError: Can't yield without a block", 1},
+		Concurrent::Array.new([]).any?(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -928,8 +928,8 @@ func TestConcurrentArrayFlattenMethodFail(t *testing.T) {
 		{`
 		require 'concurrent/array'
 		a = Concurrent::Array.new([1, 2])
-		a.flatten(1)
-		`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		a.flatten(1, 2)
+		`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
 	}
 
 	for i, tt := range testsFail {
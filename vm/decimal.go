@@ -306,11 +306,13 @@ var builtinDecimalInstanceMethods = []*BuiltinMethodObject{
 		// x < y: -1
 		// x == y: 0 (including -0 == 0, -Infinity == +Infinity and vice versa)
 		// x > y: 1
+		// Returns nil if the second term is not a Numeric.
 		//
 		// ```Ruby
-		// "1.5".to_d <=> 3 # => -1
-		// "1.0".to_d <=> 1 # => 0
-		// "3.5".to_d <=> 1 # => 1
+		// "1.5".to_d <=> 3   # => -1
+		// "1.0".to_d <=> 1   # => 0
+		// "3.5".to_d <=> 1   # => 1
+		// "1.5".to_d <=> "a" # => nil
 		// ```
 		//
 		// @return [Integer]
@@ -667,7 +669,7 @@ func (d *DecimalObject) rocketComparison(
 
 	rightValue, ok := assertNumeric(rightObject)
 	if ok == false {
-		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", rightObject.Class().Name)
+		return NULL
 	}
 
 	leftValue := d.value
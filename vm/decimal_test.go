@@ -127,6 +127,7 @@ func TestDecimalComparisonWithFloat(t *testing.T) {
 		{`'1.5'.to_d <=> '2.5'.to_d`, -1},
 		{`'2.5'.to_d <=> '1.5'.to_d`, 1},
 		{`'3.5'.to_d <=> '3.5'.to_d`, 0},
+		{`'1'.to_d <=> "m"`, nil},
 	}
 
 	for i, tt := range tests {
@@ -175,7 +176,6 @@ func TestDecimalComparisonFail(t *testing.T) {
 		{`'1'.to_d >= "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 		{`'1'.to_d < "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 		{`'1'.to_d <= "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
-		{`'1'.to_d <=> "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -0,0 +1,166 @@
+package vm
+
+import (
+	"testing"
+)
+
+const linkedListClass = `
+class LinkedList
+  include Enumerable
+
+  class Node
+    attr_reader :value, :next_node
+
+    def initialize(value, next_node)
+      @value = value
+      @next_node = next_node
+    end
+  end
+
+  def initialize(*values)
+    @head = nil
+
+    values.reverse.each do |value|
+      @head = Node.new(value, @head)
+    end
+  end
+
+  def each
+    node = @head
+
+    while !node.nil? do
+      yield(node.value)
+      node = node.next_node
+    end
+  end
+end
+`
+
+// numberListClass passes the elements of an array on from the array's `each`
+const numberListClass = `
+class NumberList
+  include Enumerable
+
+  def initialize(*numbers)
+    @numbers = numbers
+  end
+
+  def each
+    @numbers.each do |n|
+      yield(n)
+    end
+  end
+end
+`
+
+func TestEnumerableMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`LinkedList.new(3, 1, 2).to_a`, []interface{}{3, 1, 2}},
+		{`LinkedList.new.to_a`, []interface{}{}},
+		{`
+		LinkedList.new(3, 1, 2).map do |i|
+		  i * 2
+		end
+		`, []interface{}{6, 2, 4}},
+		{`
+		LinkedList.new(3, 1, 2).select do |i|
+		  i > 1
+		end
+		`, []interface{}{3, 2}},
+		{`
+		LinkedList.new(3, 1, 2).reject do |i|
+		  i > 1
+		end
+		`, []interface{}{1}},
+		{`
+		LinkedList.new(3, 1, 2).find do |i|
+		  i < 3
+		end
+		`, 1},
+		{`
+		LinkedList.new(3, 1, 2).find do |i|
+		  i > 3
+		end
+		`, nil},
+		{`LinkedList.new(3, 1, 2).count`, 3},
+		{`LinkedList.new(3, 1, 3).count(3)`, 2},
+		{`
+		LinkedList.new(3, 1, 2).count do |i|
+		  i > 1
+		end
+		`, 2},
+		{`LinkedList.new(3, 1, 2).include?(2)`, true},
+		{`LinkedList.new(3, 1, 2).include?(5)`, false},
+		{`
+		LinkedList.new(3, 1, 2).reduce do |sum, i|
+		  sum + i
+		end
+		`, 6},
+		{`
+		LinkedList.new(3, 1, 2).reduce(10) do |sum, i|
+		  sum + i
+		end
+		`, 16},
+		{`LinkedList.new(3, 1, 2).min`, 1},
+		{`LinkedList.new(3, 1, 2).max`, 3},
+		{`LinkedList.new.min`, nil},
+		{`LinkedList.new("b", "c", "a").max`, "c"},
+		{`LinkedList.new(3, 1, 2).sort`, []interface{}{1, 2, 3}},
+		{`
+		LinkedList.new(3, 1, 2).sort do |x, y|
+		  y <=> x
+		end
+		`, []interface{}{3, 2, 1}},
+		{`LinkedList.new(3, 1, 2).first`, 3},
+		{`LinkedList.new.first`, nil},
+		{`LinkedList.new(3, 1, 2).first(2)`, []interface{}{3, 1}},
+		{`LinkedList.new(3, 1, 2).first(5)`, []interface{}{3, 1, 2}},
+		{`LinkedList.new(3, 1, 2).first(0)`, []interface{}{}},
+		{`LinkedList.new.is_a?(Enumerable)`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, linkedListClass+tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestEnumerableMethodsWithDelegatedEach(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`NumberList.new(1, 2, 3).to_a`, []interface{}{1, 2, 3}},
+		{`
+		NumberList.new(1, 2, 3).find do |n|
+		  n > 1
+		end
+		`, 2},
+		{`
+		NumberList.new(1, 2, 3).find do |n|
+		  n > 3
+		end
+		`, nil},
+		{`NumberList.new(1, 2, 3).include?(2)`, true},
+		{`NumberList.new(1, 2, 3).include?(5)`, false},
+		{`NumberList.new(1, 2, 3).first`, 1},
+		{`NumberList.new.first`, nil},
+		{`NumberList.new(1, 2, 3).first(1)`, []interface{}{1}},
+		{`NumberList.new(1, 2, 3).first(2)`, []interface{}{1, 2}},
+		{`NumberList.new(1, 2, 3).first(5)`, []interface{}{1, 2, 3}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, numberListClass+tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
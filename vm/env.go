@@ -0,0 +1,440 @@
+package vm
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// EnvObject is the `ENV` object, which reads and writes environment variables like a hash.
+// Changes go to the process's environment, so child processes see them.
+//
+// ```ruby
+// ENV["HOME"]                  #=> "/home/goby"
+// ENV["GOBY_ENV"] = "test"
+// ENV.fetch("GOBY_ENV")        #=> "test"
+// ENV.fetch("MISSING", "none") #=> "none"
+// ```
+//
+// It also has the methods of `Hash` that don't change the hash, like `each`, `map`, `select`, `to_a` and `values`,
+// which go through the variables in alphabetical order of the names. See `envHashMethodNames` for the full list.
+//
+// The VM can be given its own environment with `VM.SetEnv`, which keeps tests away from the real environment.
+type EnvObject struct {
+	*BaseObj
+}
+
+// Instance methods -----------------------------------------------------
+var builtinEnvInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the value of the environment variable, or nil if it doesn't exist.
+		//
+		// ```ruby
+		// ENV["HOME"]    #=> "/home/goby"
+		// ENV["MISSING"] #=> nil
+		// ```
+		//
+		// @param name [String]
+		// @return [String]
+		Name: "[]",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			name, ok := args[0].(*StringObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+			}
+
+			value, ok := t.vm.getenv(name.value)
+			if !ok {
+				return NULL
+			}
+
+			return t.vm.InitStringObject(value)
+
+		},
+	},
+	{
+		// Sets the environment variable and returns the value. Setting nil removes the variable.
+		//
+		// ```ruby
+		// ENV["GOBY_ENV"] = "test"
+		// ENV["GOBY_ENV"]          #=> "test"
+		// ENV["GOBY_ENV"] = nil
+		// ENV["GOBY_ENV"]          #=> nil
+		// ```
+		//
+		// @param name [String], value [String]
+		// @return [String]
+		Name: "[]=",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 2, len(args))
+			}
+
+			name, ok := args[0].(*StringObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.StringClass, args[0].Class().Name)
+			}
+
+			switch value := args[1].(type) {
+			case *StringObject:
+				t.vm.setenv(name.value, value.value)
+			case *NullObject:
+				t.vm.unsetenv(name.value)
+			default:
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, classes.StringClass, args[1].Class().Name)
+			}
+
+			return args[1]
+
+		},
+	},
+	{
+		// Removes the environment variable, and returns its value or nil if it doesn't exist.
+		//
+		// ```ruby
+		// ENV["GOBY_ENV"] = "test"
+		// ENV.delete("GOBY_ENV") #=> "test"
+		// ENV["GOBY_ENV"]        #=> nil
+		// ```
+		//
+		// @param name [String]
+		// @return [String]
+		Name: "delete",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			name, ok := args[0].(*StringObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+			}
+
+			value, ok := t.vm.getenv(name.value)
+			if !ok {
+				return NULL
+			}
+
+			t.vm.unsetenv(name.value)
+
+			return t.vm.InitStringObject(value)
+
+		},
+	},
+	{
+		// Calls the block with the name and value of each environment variable in alphabetical order,
+		// and returns ENV.
+		//
+		// ```ruby
+		// ENV.each do |name, value|
+		//   puts(name + "=" + value)
+		// end
+		// ```
+		//
+		// @param block
+		// @return [Env]
+		Name: "each",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if err, ok := receiver.(*EnvObject).callHashMethod(t, "each", args, blockFrame, sourceLine).(*Error); ok {
+				return err
+			}
+
+			return receiver
+
+		},
+	},
+	{
+		// Returns the value of the environment variable like `Hash#fetch`.
+		// If the variable doesn't exist, returns the default value or the result of the block,
+		// or raises a KeyError if neither is given.
+		//
+		// ```ruby
+		// ENV.fetch("HOME")                          #=> "/home/goby"
+		// ENV.fetch("MISSING", "none")               #=> "none"
+		// ENV.fetch("MISSING") do |name| name + "!" end #=> "MISSING!"
+		// ENV.fetch("MISSING")                       #=> KeyError
+		// ```
+		//
+		// @param name [String], default value [Object]
+		// @return [Object]
+		Name: "fetch",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			aLen := len(args)
+			if aLen < 1 || aLen > 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
+			}
+
+			name, ok := args[0].(*StringObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+			}
+
+			if aLen == 2 && blockFrame != nil {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "The default argument can't be passed along with a block")
+			}
+
+			value, ok := t.vm.getenv(name.value)
+
+			if ok {
+				if blockFrame != nil {
+					t.callFrameStack.pop()
+				}
+				return t.vm.InitStringObject(value)
+			}
+
+			if aLen == 2 {
+				return args[1]
+			}
+
+			if blockFrame != nil {
+				if blockIsEmpty(blockFrame) {
+					t.callFrameStack.pop()
+					return NULL
+				}
+				return t.builtinMethodYield(blockFrame, name).Target
+			}
+			return t.vm.InitErrorObject(errors.KeyError, sourceLine, errors.KeyNotFound, name.Inspect())
+
+		},
+	},
+	{
+		// Returns true if the environment variable exists.
+		//
+		// ```ruby
+		// ENV.has_key?("HOME")    #=> true
+		// ENV.has_key?("MISSING") #=> false
+		// ```
+		//
+		// @param name [String]
+		// @return [Boolean]
+		Name: "has_key?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			name, ok := args[0].(*StringObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+			}
+
+			_, ok = t.vm.getenv(name.value)
+
+			return toBooleanObject(ok)
+
+		},
+	},
+	{
+		// Returns the names of the environment variables in alphabetical order.
+		//
+		// ```ruby
+		// ENV.keys #=> ["HOME", "LANG", "PATH"]
+		// ```
+		//
+		// @return [Array]
+		Name: "keys",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			env := t.vm.environ()
+			names := make([]string, 0, len(env))
+
+			for name := range env {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+
+			keys := make([]Object, len(names))
+
+			for i, name := range names {
+				keys[i] = t.vm.InitStringObject(name)
+			}
+
+			return t.vm.InitArrayObject(keys)
+
+		},
+	},
+	{
+		// Returns the environment variables as a new hash. Changing the hash doesn't change the environment.
+		//
+		// ```ruby
+		// ENV.to_h["HOME"] #=> "/home/goby"
+		// ```
+		//
+		// @return [Hash]
+		Name: "to_h",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			return receiver.(*EnvObject).toHash(t.vm)
+
+		},
+	},
+}
+
+// envHashMethodNames are the methods of Hash that only read the hash, which ENV also has.
+// They work on the environment variables in alphabetical order of the names.
+var envHashMethodNames = []string{
+	"any?", "dig", "each_key", "each_value", "empty?", "fetch_values", "has_value?", "include?", "key?",
+	"length", "map", "map_values", "reject", "select", "sorted_keys", "to_a", "transform_values", "values", "values_at",
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initEnvObject() *EnvObject {
+	return &EnvObject{
+		BaseObj: &BaseObj{class: vm.TopLevelClass(classes.EnvClass)},
+	}
+}
+
+func (vm *VM) initEnvClass() *RClass {
+	ec := vm.initializeClass(classes.EnvClass)
+	ec.setBuiltinMethods(envHashMethods(), false)
+	ec.setBuiltinMethods(builtinEnvInstanceMethods, false)
+	return ec
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the object
+func (e *EnvObject) Value() interface{} {
+	return e
+}
+
+// ToString returns the object's name as the string format
+func (e *EnvObject) ToString() string {
+	return "ENV"
+}
+
+// Inspect delegates to ToString
+func (e *EnvObject) Inspect() string {
+	return e.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (e *EnvObject) ToJSON(t *Thread) string {
+	return "\"" + e.ToString() + "\""
+}
+
+// Other helper functions -----------------------------------------------
+
+// toHash returns the environment variables as a hash
+func (e *EnvObject) toHash(vm *VM) *HashObject {
+	pairs := map[string]Object{}
+
+	for name, value := range vm.environ() {
+		pairs[name] = vm.InitStringObject(value)
+	}
+
+	return vm.InitHashObject(pairs)
+}
+
+// callHashMethod calls the Hash's builtin method of the name on the environment variables as a hash.
+// The builtin is taken from the method table, so reopening Hash doesn't change ENV.
+func (e *EnvObject) callHashMethod(t *Thread, name string, args []Object, blockFrame *normalCallFrame, sourceLine int) Object {
+	return builtinHashMethod(name).Fn(e.toHash(t.vm), sourceLine, t, args, blockFrame)
+}
+
+// builtinHashMethod returns the Hash's builtin instance method of the name
+func builtinHashMethod(name string) *BuiltinMethodObject {
+	for _, m := range builtinHashInstanceMethods {
+		if m.Name == name {
+			return m
+		}
+	}
+
+	panic("Hash has no builtin method " + name)
+}
+
+// envHashMethods returns the methods that run the Hash's reading methods on the environment variables
+func envHashMethods() []*BuiltinMethodObject {
+	methods := make([]*BuiltinMethodObject, len(envHashMethodNames))
+
+	for i, name := range envHashMethodNames {
+		name := name
+		methods[i] = &BuiltinMethodObject{
+			Name: name,
+			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+				return receiver.(*EnvObject).callHashMethod(t, name, args, blockFrame, sourceLine)
+			},
+		}
+	}
+
+	return methods
+}
+
+// getenv returns the value of the environment variable from the VM's environment, or from the process's if it's not set
+func (vm *VM) getenv(name string) (string, bool) {
+	vm.envLock.RLock()
+	defer vm.envLock.RUnlock()
+
+	if vm.env == nil {
+		return os.LookupEnv(name)
+	}
+
+	value, ok := vm.env[name]
+	return value, ok
+}
+
+// setenv sets the environment variable to the VM's environment, or to the process's if it's not set
+func (vm *VM) setenv(name, value string) {
+	vm.envLock.Lock()
+	defer vm.envLock.Unlock()
+
+	if vm.env == nil {
+		os.Setenv(name, value)
+		return
+	}
+
+	vm.env[name] = value
+}
+
+// unsetenv removes the environment variable from the VM's environment, or from the process's if it's not set
+func (vm *VM) unsetenv(name string) {
+	vm.envLock.Lock()
+	defer vm.envLock.Unlock()
+
+	if vm.env == nil {
+		os.Unsetenv(name)
+		return
+	}
+
+	delete(vm.env, name)
+}
+
+// environ returns a copy of all the environment variables
+func (vm *VM) environ() map[string]string {
+	vm.envLock.RLock()
+	defer vm.envLock.RUnlock()
+
+	env := map[string]string{}
+
+	if vm.env == nil {
+		for _, e := range os.Environ() {
+			pair := strings.SplitN(e, "=", 2)
+			env[pair[0]] = pair[1]
+		}
+
+		return env
+	}
+
+	for name, value := range vm.env {
+		env[name] = value
+	}
+
+	return env
+}
@@ -0,0 +1,253 @@
+package vm
+
+import (
+	"os"
+	"testing"
+)
+
+func initTestEnv() map[string]string {
+	return map[string]string{
+		"HOME": "/home/goby",
+		"LANG": "en_US.UTF-8",
+		"OPTS": "a=1,b=2",
+	}
+}
+
+func TestEnvObject(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`ENV.class.name`, "Env"},
+		{`ENV.to_s`, "ENV"},
+		{`ENV.inspect`, "ENV"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetEnv(initTestEnv())
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestEnvReadingMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`ENV["HOME"]`, "/home/goby"},
+		{`ENV["OPTS"]`, "a=1,b=2"},
+		{`ENV["MISSING"]`, nil},
+		{`ENV.has_key?("LANG")`, true},
+		{`ENV.has_key?("MISSING")`, false},
+		{`ENV.keys`, []interface{}{"HOME", "LANG", "OPTS"}},
+		{`ENV.to_h["LANG"]`, "en_US.UTF-8"},
+		{`ENV.to_h.length`, 3},
+		{`
+		h = ENV.to_h
+		h["HOME"] = "/tmp"
+		ENV["HOME"]
+		`, "/home/goby"},
+		{`ENV.length`, 3},
+		{`ENV.empty?`, false},
+		{`ENV.key?("HOME")`, true},
+		{`ENV.include?("MISSING")`, false},
+		{`ENV.values`, []interface{}{"/home/goby", "en_US.UTF-8", "a=1,b=2"}},
+		{`
+		names = []
+		ENV.each do |name, value|
+		  names.push(name)
+		end
+		names
+		`, []interface{}{"HOME", "LANG", "OPTS"}},
+		{`
+		ENV.each do |name, value|
+		end.to_s
+		`, "ENV"},
+		{`
+		ENV.map do |name, value|
+		  name + "=" + value
+		end
+		`, []interface{}{"HOME=/home/goby", "LANG=en_US.UTF-8", "OPTS=a=1,b=2"}},
+		{`ENV.to_a.first`, []interface{}{"HOME", "/home/goby"}},
+		{`ENV.has_value?("en_US.UTF-8")`, true},
+		{`ENV.values_at("HOME", "MISSING")`, []interface{}{"/home/goby", nil}},
+		{`
+		ENV.select do |name, value|
+		  name == "LANG"
+		end.keys
+		`, []interface{}{"LANG"}},
+		{`
+		ENV.any? do |name, value|
+		  value == "a=1,b=2"
+		end
+		`, true},
+		{`
+		class Hash
+		  def length
+		    42
+		  end
+		end
+		ENV.length
+		`, 3},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetEnv(initTestEnv())
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestEnvWritingMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`ENV["GOBY_ENV"] = "test"`, "test"},
+		{`
+		ENV["GOBY_ENV"] = "test"
+		ENV["GOBY_ENV"]
+		`, "test"},
+		{`
+		ENV["HOME"] = "/tmp"
+		ENV["HOME"]
+		`, "/tmp"},
+		{`
+		ENV["GOBY_ENV"] = "test"
+		ENV.keys
+		`, []interface{}{"GOBY_ENV", "HOME", "LANG", "OPTS"}},
+		{`
+		ENV["HOME"] = nil
+		ENV.has_key?("HOME")
+		`, false},
+		{`ENV.delete("HOME")`, "/home/goby"},
+		{`
+		ENV.delete("HOME")
+		ENV["HOME"]
+		`, nil},
+		{`ENV.delete("MISSING")`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetEnv(initTestEnv())
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestEnvWritingMethodsChangeInjectedEnv(t *testing.T) {
+	env := initTestEnv()
+	v := initTestVM()
+	v.SetEnv(env)
+	v.testEval(t, `
+	ENV["GOBY_TEST_ENV"] = "test"
+	ENV.delete("HOME")
+	`, getFilename())
+
+	if env["GOBY_TEST_ENV"] != "test" {
+		t.Errorf("Expect GOBY_TEST_ENV to be set in the injected env. got: %q", env["GOBY_TEST_ENV"])
+	}
+
+	if _, ok := env["HOME"]; ok {
+		t.Errorf("Expect HOME to be removed from the injected env")
+	}
+
+	if _, ok := os.LookupEnv("GOBY_TEST_ENV"); ok {
+		t.Errorf("Expect GOBY_TEST_ENV not to be set in the process's env")
+	}
+}
+
+func TestEnvWritingMethodsChangeProcessEnv(t *testing.T) {
+	defer os.Unsetenv("GOBY_TEST_ENV")
+
+	v := initTestVM()
+	evaluated := v.testEval(t, `
+	ENV["GOBY_TEST_ENV"] = "test"
+	ENV["GOBY_TEST_ENV"]
+	`, getFilename())
+	VerifyExpected(t, 0, evaluated, "test")
+
+	if os.Getenv("GOBY_TEST_ENV") != "test" {
+		t.Errorf("Expect GOBY_TEST_ENV to be set in the process's env. got: %q", os.Getenv("GOBY_TEST_ENV"))
+	}
+}
+
+func TestEnvFetchMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`ENV.fetch("HOME")`, "/home/goby"},
+		{`ENV.fetch("HOME", "/tmp")`, "/home/goby"},
+		{`ENV.fetch("MISSING", "default")`, "default"},
+		{`ENV.fetch("MISSING", nil)`, nil},
+		{`
+		ENV.fetch("HOME") do |name|
+		  name + "!"
+		end
+		`, "/home/goby"},
+		{`
+		ENV.fetch("MISSING") do |name|
+		  name + "!"
+		end
+		`, "MISSING!"},
+		{`
+		ENV.fetch("MISSING") do |name|
+		end
+		`, nil},
+		{`
+		ENV.fetch("HOME") do |name|
+		end
+		`, "/home/goby"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetEnv(initTestEnv())
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestEnvMethodsFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`ENV[1]`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`ENV.send("[]")`, "ArgumentError: Expect 1 argument(s). got: 0", 2},
+		{`ENV[1] = "a"`, "TypeError: Expect argument #1 to be String. got: Integer", 1},
+		{`ENV["A"] = 1`, "TypeError: Expect argument #2 to be String. got: Integer", 1},
+		{`ENV.send("[]=", "A")`, "ArgumentError: Expect 2 argument(s). got: 1", 2},
+		{`ENV.delete(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`ENV.has_key?`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`ENV.keys(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`ENV.to_h(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`ENV.fetch`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`ENV.fetch("A", "b", "c")`, "ArgumentError: Expect 1 to 2 argument(s). got: 3", 1},
+		{`ENV.fetch(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`ENV.fetch("MISSING")`, `KeyError: Key not found: "MISSING"`, 1},
+		{`ENV.fetch("HOME", "b") do end`, "ArgumentError: The default argument can't be passed along with a block", 1},
+		{`ENV.each`, "InternalError: Can't yield without a block", 1},
+		{`ENV.length(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`ENV.key?(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		v.SetEnv(initTestEnv())
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
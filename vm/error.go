@@ -20,11 +20,41 @@ import (
 type Error struct {
 	*BaseObj
 	message      string
+	// text is the message without the error type, which `message` returns
+	text         string
 	stackTraces  []string
 	storedTraces bool
 	Type         string
 }
 
+// Instance methods -----------------------------------------------------
+var builtinErrorInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the error's message without the error type.
+		//
+		// ```ruby
+		// # for the error raised by `raise ArgumentError, "bad"`
+		// e.message # => "bad"
+		// ```
+		//
+		// @return [String]
+		Name: "message",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			e, ok := receiver.(*Error)
+			if !ok {
+				return t.vm.InitStringObject("")
+			}
+
+			return t.vm.InitStringObject(e.text)
+
+		},
+	},
+}
+
 // Internal functions ===================================================
 
 // Functions for initialization -----------------------------------------
@@ -34,35 +64,66 @@ func (vm *VM) InitNoMethodError(sourceLine int, methodName string, receiver Obje
 	return vm.InitErrorObject(errors.NoMethodError, sourceLine, errors.UndefinedMethod, methodName, receiver.Inspect())
 }
 
+// InitFrozenError is a helper function for generating the error of modifying a frozen object
+func (vm *VM) InitFrozenError(sourceLine int, receiver Object) *Error {
+	return vm.InitErrorObject(errors.FrozenError, sourceLine, errors.CantModifyFrozen, receiver.Class().Name, receiver.Inspect())
+}
+
+// InitErrorObject initializes an error object, which is traced from the main thread's current call frame.
+// Since it's also called from other threads, it only reads the call frame and never modifies the main thread.
 func (vm *VM) InitErrorObject(errorType string, sourceLine int, format string, args ...interface{}) *Error {
-	errClass := vm.objectClass.getClassConstant(errorType)
-
-	t := &vm.mainThread
-	cf := t.callFrameStack.top()
-
-	switch cf := cf.(type) {
-	case *normalCallFrame:
-		// If program counter is 0 means we need to trace back to previous call frame
-		if cf.pc == 0 {
-			t.callFrameStack.pop()
-			cf = t.callFrameStack.top().(*normalCallFrame)
-		}
-	}
+	return vm.newErrorObject(vm.currentFileName(), errorType, sourceLine, format, args...)
+}
+
+// initRaisedError initializes the error of the class raised by `raise`.
+// The class is taken as it is instead of by its name, so classes inside modules can be raised too.
+// The message is quoted in the error's string, while `message` returns it as given.
+func (vm *VM) initRaisedError(errClass *RClass, sourceLine int, message string) *Error {
+	err := vm.newErrorObjectOfClass(vm.currentFileName(), errClass, sourceLine, "'%s'", message)
+	err.text = message
+	return err
+}
+
+func (vm *VM) newErrorObject(fileName, errorType string, sourceLine int, format string, args ...interface{}) *Error {
+	return vm.newErrorObjectOfClass(fileName, vm.objectClass.getClassConstant(errorType), sourceLine, format, args...)
+}
+
+func (vm *VM) newErrorObjectOfClass(fileName string, errClass *RClass, sourceLine int, format string, args ...interface{}) *Error {
+	text := fmt.Sprintf(format, args...)
 
 	return &Error{
 		BaseObj: &BaseObj{class: errClass},
 		// Add 1 to source line because it's zero indexed
-		message:     fmt.Sprintf(errorType+": "+format, args...),
-		stackTraces: []string{fmt.Sprintf("from %s:%d", cf.FileName(), sourceLine)},
-		Type:        errorType,
+		message:     errClass.Name + ": " + text,
+		text:        text,
+		stackTraces: []string{fmt.Sprintf("from %s:%d", fileName, sourceLine)},
+		Type:        errClass.Name,
+	}
+}
+
+// currentFileName returns the file name of the main thread's current call frame
+func (vm *VM) currentFileName() string {
+	if cf := vm.mainThread.callFrameStack.top(); cf != nil {
+		return cf.FileName()
 	}
+
+	return ""
 }
 
 func (vm *VM) initErrorClasses() {
-	errTypes := []string{errors.InternalError, errors.IOError, errors.ArgumentError, errors.NameError, errors.StopIteration, errors.TypeError, errors.NoMethodError, errors.ConstantAlreadyInitializedError, errors.HTTPError, errors.ZeroDivisionError, errors.ChannelCloseError, errors.NotImplementedError}
+	ec := vm.initializeClass(errors.Exception)
+	ec.setBuiltinMethods(builtinErrorInstanceMethods, false)
+	vm.objectClass.setClassConstant(ec)
+
+	sc := vm.initializeClass(errors.StandardError)
+	sc.inherits(ec)
+	vm.objectClass.setClassConstant(sc)
+
+	errTypes := []string{errors.InternalError, errors.IOError, errors.ArgumentError, errors.NameError, errors.StopIteration, errors.TypeError, errors.NoMethodError, errors.ConstantAlreadyInitializedError, errors.HTTPError, errors.ZeroDivisionError, errors.ChannelCloseError, errors.KeyError, errors.RuntimeError, errors.NotImplementedError, errors.FrozenError, errors.RangeError}
 
 	for _, errType := range errTypes {
 		c := vm.initializeClass(errType)
+		c.inherits(sc)
 		vm.objectClass.setClassConstant(c)
 	}
 }
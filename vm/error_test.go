@@ -111,7 +111,7 @@ func TestStackTraces(t *testing.T) {
 			// receiver(mainObject), receiver, argument 10, errorObject
 			4,
 		},
-		{`class FooError; end
+		{`class FooError < StandardError; end
 
 		def raise_foo
 		  raise FooError, "Foo"
@@ -389,6 +389,20 @@ func TestKeywordArgumentError(t *testing.T) {
 		foo(y: 1, x: 100)
 		`,
 			"ArgumentError: Expect at most 1 args for method 'foo'. got: 2", 1},
+		{`def connect(host, port: 8080)
+		  host
+		end
+
+		connect("x", post: 9090)
+		`,
+			"ArgumentError: unknown key post for method connect", 1},
+		{`def connect(host, path:, port: 8080)
+		  host
+		end
+
+		connect("x", port: 9090)
+		`,
+			"ArgumentError: Method connect requires key argument path", 1},
 	}
 
 	for i, tt := range tests {
@@ -422,6 +436,73 @@ func TestConstantAlreadyInitializedError(t *testing.T) {
 	}
 }
 
+func TestErrorClassHierarchy(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`StandardError.superclass.name`, "Exception"},
+		{`Exception.superclass.name`, "Object"},
+		{`ArgumentError.superclass.name`, "StandardError"},
+		{`KeyError.superclass.name`, "StandardError"},
+		{`RuntimeError.ancestors.to_s`, "[RuntimeError, StandardError, Exception, Object]"},
+		{`
+		class MyError < StandardError; end
+		class MySubError < MyError; end
+		MySubError.ancestors.to_s
+		`, "[MySubError, MyError, StandardError, Exception, Object]"},
+		{`
+		class MyError < StandardError; end
+		MyError.ancestors.include?(ArgumentError)
+		`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestUserDefinedError(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMsg     string
+		expectedClass   string
+		expectedMessage string
+	}{
+		{`
+		class MyError < StandardError; end
+		raise MyError, "boom"
+		`, "MyError: 'boom'", "MyError", "boom"},
+		{`
+		class MyError < StandardError; end
+		class MySubError < MyError; end
+		raise MySubError
+		`, "MySubError: 'MySubError'", "MySubError", "MySubError"},
+		{`raise "boom"`, "RuntimeError: 'boom'", "RuntimeError", "boom"},
+		{`raise "it's"`, "RuntimeError: 'it's'", "RuntimeError", "it's"},
+		{`1 / 0`, "ZeroDivisionError: Divided by 0", "ZeroDivisionError", "Divided by 0"},
+		{`raise`, "RuntimeError: unhandled exception", "RuntimeError", "unhandled exception"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expectedMsg)
+
+		err := evaluated.(*Error)
+		if err.Class().Name != tt.expectedClass {
+			t.Fatalf("At test case %d: Expect error class to be %s. got: %s", i, tt.expectedClass, err.Class().Name)
+		}
+
+		message := err.findMethod("message").(*BuiltinMethodObject).Fn(err, 0, &v.mainThread, []Object{}, nil)
+		VerifyExpected(t, i, message, tt.expectedMessage)
+	}
+}
+
 // Error test helper methods
 
 func checkErrorMsg(t *testing.T, index int, evaluated Object, expectedErrMsg string) {
@@ -464,7 +545,7 @@ func checkFuzzifiedErrorMsg(t *testing.T, index int, evaluated Object, expectedE
 }
 
 func fuzzifyMessage(message string) string {
-	re, _ := regexp2.Compile("(?<=#<[a-zA-Z0-9_]+:)[0-9]{12}(?=[ ]>?)", 0)
+	re, _ := regexp2.Compile("(?<=#<[a-zA-Z0-9_]+:)[0-9]+(?=[ ]>?)", 0)
 	fuzMsg, _ := re.Replace(message, "##OBJECTID##", 0, -1)
 	return fuzMsg
 }
@@ -1,6 +1,10 @@
 package errors
 
 const (
+	// Exception is the root of all error types
+	Exception = "Exception"
+	// StandardError is the parent of the built-in error types, and is meant to be inherited by user-defined errors
+	StandardError = "StandardError"
 	// InternalError is the default error type
 	InternalError = "InternalError"
 	// IOError is an IO error such as file error
@@ -23,6 +27,14 @@ const (
 	ZeroDivisionError = "ZeroDivisionError"
 	// ChannelCloseError is for accessing to the closed channel
 	ChannelCloseError = "ChannelCloseError"
+	// KeyError is for looking up a key that doesn't exist
+	KeyError = "KeyError"
+	// RuntimeError is the default error type for `raise`
+	RuntimeError = "RuntimeError"
+	// FrozenError is for modifying a frozen object
+	FrozenError = "FrozenError"
+	// RangeError is for a value out of the range that can be represented
+	RangeError = "RangeError"
 
 	NotImplementedError = "NotImplementedError"
 )
@@ -39,6 +51,7 @@ const (
 	WrongArgumentTypeFormatNum      = "Expect argument #%d to be %s. got: %s"
 	InvalidChmodNumber              = "Invalid chmod number. got: %d"
 	InvalidNumericString            = "Invalid numeric string. got: %s"
+	InvalidRadix                    = "Invalid radix. got: %d"
 	CantLoadFile                    = "Can't load \"%s\""
 	CantRequireNonString            = "Can't require \"%s\": Pass a string instead"
 	CantYieldWithoutBlockFormat     = "Can't yield without a block"
@@ -47,9 +60,23 @@ const (
 	ChannelIsClosed                 = "The channel is already closed."
 	TooSmallIndexValue              = "Index value %d too small for array. minimum: %d"
 	IndexOutOfRange                 = "Index value out of range. got: %v"
+	KeyNotFound                     = "Key not found: %s"
 	RegexpFailure                   = "Replacement failure with the Regexp. got: %s"
 	NegativeValue                   = "Expect argument to be positive value. got: %d"
 	NegativeSecondValue             = "Expect second argument to be positive value. got: %d"
+	ZeroStep                        = "Step can't be 0"
 	NativeNotImplementedErrorFormat = "'%s' should be implemented on %s but haven't be done yet. Looking forward to see your PR for it ;-)"
 	UndefinedMethod                 = "Undefined Method '%+v' for %+v"
+	ComparisonFailed                = "Comparison of %s with %s failed"
+	WrongBlockReturnType            = "Expect the block to return %s. got: %s"
+	CantModifyFrozen                = "Can't modify frozen %s: %s"
+	CantClone                       = "Can't clone %s"
+	MalformedFormatString           = "Malformed format string: %s"
+	EmptyPadding                    = "Expect padding to be a non-empty String"
+	InvalidRandomLimit              = "Invalid limit for random numbers. got: %s"
+	MutexNotLocked                  = "Attempt to unlock a mutex which is not locked"
+	UnhandledException              = "unhandled exception"
+	ExceptionExpected               = "Expect an Exception class or a String. got: %s"
+	NotExceptionClass               = "Expect a subclass of Exception. got: %s"
+	IntegerOverflow                 = "Integer overflow: %d ** %d"
 )
@@ -130,6 +130,51 @@ func TestAssignmentEvaluation(t *testing.T) {
 		h[:foo] ||= 2
 		h[:foo]
 		`, 2},
+		{`
+		a = [1, nil]
+		a[0] ||= 2
+		a[0]
+		`, 1},
+		{`
+		a = [1, nil]
+		a[0] &&= 5
+		a[0]
+		`, 5},
+		{`
+		a = [1, nil]
+		a[1] &&= 5
+		a.length
+		`, 2},
+		{`
+		h = { foo: 3 }
+		h[:foo] &&= 4
+		h[:foo]
+		`, 4},
+		{`
+		h = {}
+		h[:foo] &&= 4
+		h.length
+		`, 0},
+		{`
+		h = {}
+		x = (h[:foo] ||= 6)
+		x
+		`, 6},
+		{`
+		@count = 0
+		def compute
+		  @count += 1
+		  10
+		end
+
+		h = {}
+		h["k"] = 1
+		h["k"] ||= compute
+		h["k"] ||= compute
+		h["j"] ||= compute
+		h["j"] ||= compute
+		@count
+		`, 1},
 	}
 
 	for i, tt := range tests {
@@ -151,6 +196,78 @@ func TestAssignmentByOperationEvaluation(t *testing.T) {
 		{"a = 5; a += 2 * 3 + 5; a;", 16},
 		{"a = 5; a -= 2 * 3 + 5; a;", -6},
 		{"a = false; a ||= true; a;", true},
+		{"a = 5; a *= 3; a;", 15},
+		{"a = 5; a *= 2 + 1; a;", 15},
+		{"a = 15; a /= 4; a;", 3},
+		{"a = 15; a %= 4; a;", 3},
+		{"a = 7.5; a /= 2.5; a;", 3.0},
+		{`a = "ab"; a *= 2; a;`, "abab"},
+		{"@a = 5; @a += 2; @a;", 7},
+		{"@a = 5; @a -= 2; @a;", 3},
+		{"@a = 5; @a *= 2; @a;", 10},
+		{"@a = 5; @a /= 2; @a;", 2},
+		{"@a = 5; @a %= 2; @a;", 1},
+		{"a = [1, 2]; a[1] += 3; a[1];", 5},
+		{"a = [1, 2]; a[1] -= 3; a[1];", -1},
+		{"a = [1, 2]; a[1] *= 3; a[1];", 6},
+		{"a = [1, 8]; a[1] /= 3; a[1];", 2},
+		{"a = [1, 8]; a[1] %= 3; a[1];", 2},
+		{"a = [1, 2]; a[-1] += 3; a.to_s;", "[1, 5]"},
+		{"a = [1, 2]; b = (a[0] += 3); b * 2;", 8},
+		{"h = { foo: 2 }; h[:foo] += 3; h[:foo];", 5},
+		{"h = { foo: 2 }; h[:foo] *= 3; h[:foo];", 6},
+		{`
+		@count = 0
+		def index
+		  @count += 1
+		  1
+		end
+
+		a = [1, 2, 3]
+		a[index] += 10
+		a[index] *= 2
+		@count * 100 + a[1]
+		`, 224},
+		{`
+		@count = 0
+		def array
+		  @count += 1
+		  @array ||= [1, 2]
+		end
+
+		array[0] += 1
+		@count
+		`, 1},
+		{`
+		@count = 0
+		def index
+		  @count += 1
+		  1
+		end
+
+		a = [1, nil, 3]
+		a[index] ||= 10
+		a[index] ||= 20
+		a[index] &&= 30
+		@count * 100 + a[1]
+		`, 330},
+		{`
+		@count = 0
+		@store = {}
+		def store
+		  @count += 1
+		  @store
+		end
+
+		store[:foo] ||= 1
+		store[:foo] &&= 2
+		store[:bar] &&= 3
+		[@count, @store[:foo], @store.length]
+		`, []interface{}{3, 2, 1}},
+		{"a = [nil]; b = (a[0] ||= 5); b * 2;", 10},
+		{"a = [1]; b = (a[0] ||= 5); b * 2;", 2},
+		{"a = [1]; b = (a[0] &&= 5); b * 2;", 10},
+		{"a = [nil]; b = (a[0] &&= 5); b.nil?;", true},
 	}
 
 	for i, tt := range tests {
@@ -755,6 +872,146 @@ func TestEnvironmentVariable(t *testing.T) {
 	os.Setenv("FOO", "")
 }
 
+func TestUnlessExpressionEvaluation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"unless 10 > 5; 100 end", nil},
+		{"unless 10 < 5; 100 end", 100},
+		{"unless nil; 100 end", 100},
+		{"unless 0; 100 end", nil},
+		{"unless 10 > 5; 100 else 200 end", 200},
+		{"unless false; 100 else 200 end", 100},
+		{`
+		a = 10
+		unless a > 5
+		  b = 1
+		else
+		  b = 2
+		end
+
+		b
+		`, 2},
+		{`
+		a = 0
+		a += 10 unless a > 5
+		a
+		`, 10},
+		{`
+		a = 10
+		a += 10 unless a > 5
+		a
+		`, 10},
+		{`
+		def foo(n)
+		  "negative" unless n >= 0
+		end
+
+		foo(-1)
+		`, "negative"},
+		{`
+		def foo(n)
+		  "negative" unless n >= 0
+		end
+
+		foo(1)
+		`, nil},
+		{`
+		[1, 2, 3].map do |i|
+		  i * 10 unless i == 2
+		end.to_s
+		`, "[10, nil, 30]"},
+		{`
+		def foo(n)
+		  return "negative" unless n >= 0
+		  "positive"
+		end
+
+		foo(-1) + " " + foo(1)
+		`, "negative positive"},
+		{`
+		def foo(n)
+		  return unless n >= 0
+		  n
+		end
+
+		foo(-1)
+		`, nil},
+		{`
+		[1, 2, 3, 4].map do |i|
+		  next 0 unless i.even?
+		  i
+		end.to_s
+		`, "[0, 2, 0, 4]"},
+		{`
+		a = []
+		[1, 2, 3].each do |i|
+		  break unless i < 3
+		  a.push(i)
+		end
+		a.to_s
+		`, "[1, 2]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestTernaryExpressionEvaluation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`10 > 5 ? 100 : -10`, 100},
+		{`10 < 5 ? 100 : -10`, -10},
+		{`nil ? 1 : 2`, 2},
+		{`false ? 1 : 2`, 2},
+		{`0 ? 1 : 2`, 1},
+		{`"" ? 1 : 2`, 1},
+		{`[].empty? ? "empty" : "not empty"`, "empty"},
+		{`1 + 1 == 2 ? 10 * 2 : 10 / 2`, 20},
+		{`false ? 1 : nil ? 2 : 3`, 3},
+		{`true ? false ? 1 : 2 : 3`, 2},
+		{`
+		a = 5
+		x = a > 3 ? "big" : "small"
+		x
+		`, "big"},
+		{`
+		def foo(n)
+		  n.even? ? n / 2 : n * 3 + 1
+		end
+
+		foo(6) + foo(3)
+		`, 13},
+		{`
+		a = 1
+		[1, 2, 3].map do |i|
+		  i == a ? "one" : i
+		end.to_s
+		`, `["one", 2, 3]`},
+		{`
+		a = 1
+		a == 1 ? a.to_s : a.to_f
+		a
+		`, 1},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestIfExpressionEvaluation(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -944,7 +1201,7 @@ func TestUnusedKeywordFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`
 		if true then puts 1 end
-		`, "NoMethodError: Undefined Method 'then' for #<Object:##OBJECTID## >", 1},
+		`, "InternalError: Can't yield without a block", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1076,6 +1333,86 @@ func TestInstanceVariableEvaluation(t *testing.T) {
 	}
 }
 
+func TestLogicalOperatorEvaluation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`nil || "default"`, "default"},
+		{`false || nil`, nil},
+		{`1 || 2`, 1},
+		{`0 || 2`, 0},
+		{`1 && 2`, 2},
+		{`nil && 2`, nil},
+		{`false && 2`, false},
+		{`nil or "default"`, "default"},
+		{`1 or 2`, 1},
+		{`1 and 2`, 2},
+		{`false and 2`, false},
+		{`1 && nil || "fallback"`, "fallback"},
+		{`false && true || true`, true},
+		{`true || false && false`, true},
+		{`nil and 1 or 2`, 2},
+		{`
+		x = nil or 2
+		x
+		`, nil},
+		{`
+		x = 1 and false
+		x
+		`, 1},
+		{`
+		x = nil || 2
+		x
+		`, 2},
+		{`
+		x = 0
+		false || true && x = 10
+		x
+		`, 10},
+		{`
+		x = 0
+		true || (x = 10)
+		x
+		`, 0},
+		{`
+		x = 0
+		false && (x = 10)
+		x
+		`, 0},
+		{`
+		x = 0
+		nil || (x = 10)
+		x
+		`, 10},
+		{`
+		x = 0
+		true and (x = 10)
+		x
+		`, 10},
+		{`
+		@count = 0
+		def bump
+		  @count += 1
+		end
+
+		true || bump
+		false && bump
+		nil or bump
+		1 and bump
+		@count
+		`, 2},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestMethodCall(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1622,6 +1959,28 @@ func TestMethodCallWithBlockArgument(t *testing.T) {
 			  ten + 20
 			end
 			`, 30},
+		{`
+		def twice(x)
+		  y = yield(x)
+		  yield(y)
+		end
+
+		twice(2) do |n|
+		  n * 10
+		end
+		`, 200},
+		{`
+		count = 0
+		def twice
+		  yield
+		  yield
+		end
+
+		twice do
+		  count += 1
+		end
+		count
+		`, 2},
 		// Get Block
 		{`
 		def foo
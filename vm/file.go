@@ -2,9 +2,11 @@ package vm
 
 import (
 	"bufio"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"syscall"
 
 	"github.com/goby-lang/goby/vm/classes"
@@ -188,6 +190,53 @@ var builtinFileClassMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Reads the file line by line, and yields each line to the block without loading the whole file.
+		// The lines keep their trailing newlines unless `true` is passed as the chomp argument.
+		//
+		// ```ruby
+		// File.foreach("lines.txt") do |line|
+		//   puts(line)
+		// end
+		//
+		// File.foreach("lines.txt", true) do |line|
+		//   line  # => "first line"
+		// end
+		// ```
+		//
+		// @param fileName [String], chomp [Boolean]
+		// @return [Null]
+		Name: "foreach",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			aLen := len(args)
+			if aLen < 1 || aLen > 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			fn, ok := args[0].(*StringObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.StringClass, args[0].Class().Name)
+			}
+
+			f, err := os.Open(fn.value)
+			if err != nil {
+				return t.vm.InitErrorObject(errors.IOError, sourceLine, err.Error())
+			}
+			defer f.Close()
+
+			result := t.vm.initFileObject(f).yieldLines(t, args[1:], blockFrame, sourceLine, 2)
+			if err, ok := result.(*Error); ok {
+				return err
+			}
+
+			return NULL
+
+		},
+	},
 	{
 		// Returns the string with joined elements.
 		// Arguments can be zero.
@@ -286,6 +335,39 @@ var builtinFileClassMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns an array of the lines in the file.
+		// The lines keep their trailing newlines unless `true` is passed as the chomp argument.
+		//
+		// ```ruby
+		// File.readlines("lines.txt")        # => ["first line\n", "second line"]
+		// File.readlines("lines.txt", true)  # => ["first line", "second line"]
+		// ```
+		//
+		// @param fileName [String], chomp [Boolean]
+		// @return [Array]
+		Name: "readlines",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			aLen := len(args)
+			if aLen < 1 || aLen > 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
+			}
+
+			fn, ok := args[0].(*StringObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.StringClass, args[0].Class().Name)
+			}
+
+			f, err := os.Open(fn.value)
+			if err != nil {
+				return t.vm.InitErrorObject(errors.IOError, sourceLine, err.Error())
+			}
+			defer f.Close()
+
+			return t.vm.initFileObject(f).readLines(t, args[1:], sourceLine, 2)
+
+		},
+	},
 	{
 		// Returns size of file in bytes.
 		//
@@ -372,6 +454,40 @@ var builtinFileInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Reads the file line by line from the current position, and yields each line to the block
+		// without loading the whole file. Returns the file.
+		// The lines keep their trailing newlines unless `true` is passed as the chomp argument.
+		//
+		// ```ruby
+		// File.open("lines.txt") do |f|
+		//   f.each_line do |line|
+		//     puts(line)
+		//   end
+		// end
+		// ```
+		//
+		// @param chomp [Boolean]
+		// @return [File]
+		Name: "each_line",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) > 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			result := receiver.(*FileObject).yieldLines(t, args, blockFrame, sourceLine, 1)
+			if err, ok := result.(*Error); ok {
+				return err
+			}
+
+			return receiver
+
+		},
+	},
 	// Returns the path and the file name.
 	//
 	// ```ruby
@@ -424,6 +540,27 @@ var builtinFileInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns an array of the lines from the current position of the file.
+		// The lines keep their trailing newlines unless `true` is passed as the chomp argument.
+		//
+		// ```ruby
+		// File.new("lines.txt").readlines        # => ["first line\n", "second line"]
+		// File.new("lines.txt").readlines(true)  # => ["first line", "second line"]
+		// ```
+		//
+		// @param chomp [Boolean]
+		// @return [Array]
+		Name: "readlines",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) > 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, len(args))
+			}
+
+			return receiver.(*FileObject).readLines(t, args, sourceLine, 1)
+
+		},
+	},
 	{
 		// Returns size of file in bytes.
 		//
@@ -485,6 +622,99 @@ func (vm *VM) initFileClass() *RClass {
 
 // Polymorphic helper functions -----------------------------------------
 
+// eachLine reads the file line by line from the current position and calls fn with each line,
+// until the end of the file or fn returns false. The trailing newline is removed from each line if chomp is true.
+// If fn stops early, the file's position is moved back to the end of the last line read.
+func (f *FileObject) eachLine(chomp bool, fn func(line string) bool) error {
+	reader := bufio.NewReader(f.File)
+
+	for {
+		line, err := reader.ReadString('\n')
+
+		if line != "" {
+			if chomp && strings.HasSuffix(line, "\n") {
+				line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+			}
+
+			if !fn(line) {
+				_, err = f.File.Seek(-int64(reader.Buffered()), io.SeekCurrent)
+				return err
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// yieldLines yields each line of the file to the block; common to `File.foreach` and `File#each_line`.
+// The optional chomp argument is counted as the argument #argNum in the error messages.
+func (f *FileObject) yieldLines(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int, argNum int) Object {
+	chomp, ok := chompArgument(args)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, argNum, classes.BooleanClass, args[0].Class().Name)
+	}
+
+	yielded := false
+	err := f.eachLine(chomp, func(line string) bool {
+		yielded = true
+		t.builtinMethodYield(blockFrame, t.vm.InitStringObject(line))
+		return !blockFrame.IsRemoved()
+	})
+
+	// If there are no lines, pop the block's call frame
+	if !yielded {
+		t.callFrameStack.pop()
+	}
+
+	if err != nil {
+		return t.vm.InitErrorObject(errors.IOError, sourceLine, err.Error())
+	}
+
+	return NULL
+}
+
+// readLines returns an array of the lines of the file; common to `File.readlines` and `File#readlines`.
+// The optional chomp argument is counted as the argument #argNum in the error messages.
+func (f *FileObject) readLines(t *Thread, args []Object, sourceLine int, argNum int) Object {
+	chomp, ok := chompArgument(args)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, argNum, classes.BooleanClass, args[0].Class().Name)
+	}
+
+	lines := []Object{}
+	err := f.eachLine(chomp, func(line string) bool {
+		lines = append(lines, t.vm.InitStringObject(line))
+		return true
+	})
+
+	if err != nil {
+		return t.vm.InitErrorObject(errors.IOError, sourceLine, err.Error())
+	}
+
+	return t.vm.InitArrayObject(lines)
+}
+
+// chompArgument returns the value of the optional Boolean argument that tells if the trailing newlines should be removed,
+// and false as the second value if the argument is not a Boolean.
+func chompArgument(args []Object) (bool, bool) {
+	if len(args) == 0 {
+		return false, true
+	}
+
+	chomp, ok := args[0].(*BooleanObject)
+	if !ok {
+		return false, false
+	}
+
+	return chomp.value, true
+}
+
 // ToString returns the object's name as the string format
 func (f *FileObject) ToString() string {
 	return "<File: " + f.File.Name() + ">"
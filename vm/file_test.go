@@ -326,6 +326,73 @@ func TestFileExtnameMethodFail(t *testing.T) {
 	}
 }
 
+func TestFileForeachMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		lines = []
+		File.foreach("../test_fixtures/file_test/lines.txt") do |line|
+		  lines.push(line)
+		end
+		lines.to_s
+		`, `["first line\n", "second line\n", "\n", "last line"]`},
+		{`
+		lines = []
+		File.foreach("../test_fixtures/file_test/lines.txt", true) do |line|
+		  lines.push(line)
+		end
+		lines.to_s
+		`, `["first line", "second line", "", "last line"]`},
+		{`
+		last = nil
+		File.foreach("../test_fixtures/file_test/lines.txt") do |line|
+		  last = line
+		end
+		last
+		`, "last line"},
+		{`
+		lines = []
+		File.foreach("../test_fixtures/file_test/lines.txt") do |line|
+		  lines.push(line)
+		  break
+		end
+		lines.length
+		`, 1},
+		{`
+		File.foreach("../test_fixtures/file_test/lines.txt") do |line|
+		end
+		`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestFileForeachMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`File.foreach`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`File.foreach("../test_fixtures/file_test/lines.txt")`, "InternalError: Can't yield without a block", 1},
+		{`File.foreach(1) do |l| end`, "TypeError: Expect argument #1 to be String. got: Integer", 1},
+		{`File.foreach("../test_fixtures/file_test/lines.txt", 1) do |l| end`, "TypeError: Expect argument #2 to be Boolean. got: Integer", 1},
+		{`File.foreach("fictitious.txt") do |l| end`, "IOError: open fictitious.txt: no such file or directory", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestFileJoinMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -437,6 +504,42 @@ func TestFileNewMethodFail(t *testing.T) {
 	}
 }
 
+func TestFileReadlinesMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`File.readlines("../test_fixtures/file_test/lines.txt").to_s`, `["first line\n", "second line\n", "\n", "last line"]`},
+		{`File.readlines("../test_fixtures/file_test/lines.txt", true).to_s`, `["first line", "second line", "", "last line"]`},
+		{`File.readlines("../test_fixtures/file_test/lines.txt", false).length`, 4},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestFileReadlinesMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`File.readlines`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`File.readlines(nil)`, "TypeError: Expect argument #1 to be String. got: Null", 1},
+		{`File.readlines("../test_fixtures/file_test/lines.txt", "true")`, "TypeError: Expect argument #2 to be Boolean. got: String", 1},
+		{`File.readlines("fictitious.txt")`, "IOError: open fictitious.txt: no such file or directory", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestFileSizeMethod(t *testing.T) {
 	input := `
 	File.size("../test_fixtures/file_test/size.gb")
@@ -544,6 +647,90 @@ func TestFileCloseMethod(t *testing.T) {
 	}
 }
 
+func TestFileEachLineMethod(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		lines = []
+		File.open("../test_fixtures/file_test/lines.txt") do |f|
+		  f.each_line do |line|
+		    lines.push(line)
+		  end
+		end
+		lines.to_s
+		`, `["first line\n", "second line\n", "\n", "last line"]`},
+		{`
+		lines = []
+		f = File.new("../test_fixtures/file_test/lines.txt")
+		f.each_line(true) do |line|
+		  lines.push(line)
+		end
+		f.close
+		lines.to_s
+		`, `["first line", "second line", "", "last line"]`},
+		{`
+		f = File.new("../test_fixtures/file_test/lines.txt")
+		g = f.each_line do |line|
+		end
+		g.name
+		`, "../test_fixtures/file_test/lines.txt"},
+		{`
+		count = 0
+		File.new("../test_fixtures/file_test/lines.txt").each_line do |line|
+		  count += 1
+		  if count == 2
+		    break
+		  end
+		end
+		count
+		`, 2},
+		{`
+		f = File.new("../test_fixtures/file_test/lines.txt")
+		f.each_line do |line|
+		  break
+		end
+		f.readlines.length
+		`, 3},
+		{`
+		File.new("/tmp/goby/empty.txt", "w").close
+		count = 0
+		File.new("/tmp/goby/empty.txt").each_line do |line|
+		  count += 1
+		end
+		count
+		`, 0},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestFileEachLineMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`File.new("../test_fixtures/file_test/lines.txt").each_line`, "InternalError: Can't yield without a block", 1},
+		{`File.new("../test_fixtures/file_test/lines.txt").each_line(true, 1) do |l| end`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`File.new("../test_fixtures/file_test/lines.txt").each_line(1) do |l| end`, "TypeError: Expect argument #1 to be Boolean. got: Integer", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestFileNameMethod(t *testing.T) {
 	setup()
 	defer teardown()
@@ -597,6 +784,44 @@ func TestFileReadMethod(t *testing.T) {
 	}
 }
 
+func TestFileInstanceReadlinesMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`File.new("../test_fixtures/file_test/lines.txt").readlines.to_s`, `["first line\n", "second line\n", "\n", "last line"]`},
+		{`File.new("../test_fixtures/file_test/lines.txt").readlines(true).last`, "last line"},
+		{`
+		f = File.new("../test_fixtures/file_test/lines.txt")
+		f.readlines
+		f.readlines.length
+		`, 0},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestFileInstanceReadlinesMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`File.new("../test_fixtures/file_test/lines.txt").readlines(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`File.new("../test_fixtures/file_test/lines.txt").readlines(nil)`, "TypeError: Expect argument #1 to be Boolean. got: Null", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestFileInstanceSizeMethod(t *testing.T) {
 	input := `
 		l = 0
@@ -237,11 +237,13 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// Returns 1 if self is larger than a Numeric, -1 if smaller. Otherwise 0.
+		// Returns nil if the Object is not a Numeric.
 		//
 		// ```Ruby
-		// 1.5 <=> 3 # => -1
-		// 1.0 <=> 1 # => 0
-		// 3.5 <=> 1 # => 1
+		// 1.5 <=> 3   # => -1
+		// 1.0 <=> 1   # => 0
+		// 3.5 <=> 1   # => 1
+		// 1.5 <=> "a" # => nil
 		// ```
 		//
 		// @return [Float]
@@ -250,7 +252,7 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 			rightNumeric, ok := args[0].(Numeric)
 
 			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+				return NULL
 			}
 
 			leftValue := receiver.(*FloatObject).value
@@ -307,6 +309,61 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns the smallest `Integer` greater than or equal to self. When the number of decimal digits
+		// is given, rounds up at that decimal place and returns a `Float`.
+		//
+		// ```Ruby
+		// 1.2.ceil        # => 2
+		// (-1.2).ceil     # => -1
+		// 3.14159.ceil(2) # => 3.15
+		// ```
+		//
+		// @param digits [Integer]
+		// @return [Numeric]
+		Name: "ceil",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*FloatObject).roundWith(t, args, sourceLine, math.Ceil)
+
+		},
+	},
+	{
+		// Returns the largest `Integer` less than or equal to self. When the number of decimal digits
+		// is given, rounds down at that decimal place and returns a `Float`.
+		//
+		// ```Ruby
+		// 1.8.floor        # => 1
+		// (-1.2).floor     # => -2
+		// 3.14159.floor(3) # => 3.141
+		// ```
+		//
+		// @param digits [Integer]
+		// @return [Numeric]
+		Name: "floor",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*FloatObject).roundWith(t, args, sourceLine, math.Floor)
+
+		},
+	},
+	{
+		// Returns self rounded to the nearest `Integer`, rounding halves to the even neighbour.
+		// When the number of decimal digits is given, rounds at that decimal place and returns a `Float`.
+		//
+		// ```Ruby
+		// 1.4.round        # => 1
+		// 1.5.round        # => 2
+		// 2.5.round        # => 2
+		// 3.14159.round(2) # => 3.14
+		// ```
+		//
+		// @param digits [Integer]
+		// @return [Numeric]
+		Name: "round",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*FloatObject).roundWith(t, args, sourceLine, math.RoundToEven)
+
+		},
+	},
 	{
 		// Converts the Integer object into Decimal object and returns it.
 		// Each digit of the float is literally transferred to the corresponding digit
@@ -413,6 +470,45 @@ func (f *FloatObject) arithmeticOperation(t *Thread, rightObject Object, operati
 	return t.vm.initFloatObject(result)
 }
 
+// roundWith rounds self with the given function, at the decimal place given by the optional digits argument;
+// common to `ceil`, `floor` and `round`.
+func (f *FloatObject) roundWith(t *Thread, args []Object, sourceLine int, round func(float64) float64) Object {
+	aLen := len(args)
+	if aLen > 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
+	}
+
+	if aLen == 0 {
+		return t.vm.InitIntegerObject(int(round(f.value)))
+	}
+
+	digits, ok := args[0].(*IntegerObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+	}
+
+	result := shiftDecimal(round(shiftDecimal(f.value, digits.value)), -digits.value)
+	if digits.value <= 0 {
+		return t.vm.InitIntegerObject(int(result))
+	}
+
+	return t.vm.initFloatObject(result)
+}
+
+// shiftDecimal multiplies the value by 10 ** n by moving the exponent of its shortest decimal representation,
+// which avoids the binary error of a plain multiplication (e.g. `1.1 * 10` is 11.000000000000002)
+func shiftDecimal(value float64, n int) float64 {
+	if value == 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return value
+	}
+
+	s := strconv.FormatFloat(value, 'e', -1, 64)
+	i := strings.IndexByte(s, 'e')
+	exp, _ := strconv.Atoi(s[i+1:])
+	shifted, _ := strconv.ParseFloat(s[:i]+"e"+strconv.Itoa(exp+n), 64)
+	return shifted
+}
+
 // Apply an equality test, returning true if the objects are considered equal,
 // and false otherwise.
 func (f *FloatObject) equalityTest(rightObject Object) bool {
@@ -103,6 +103,7 @@ func TestFloatComparisonWithFloat(t *testing.T) {
 		{`1.5 <=> 2.5`, -1},
 		{`2.5 <=> 1.5`, 1},
 		{`3.5 <=> 3.5`, 0},
+		{`1.5 <=> "m"`, nil},
 	}
 
 	for i, tt := range tests {
@@ -151,7 +152,6 @@ func TestFloatComparisonFail(t *testing.T) {
 		{`1 >= "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 		{`1 < "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 		{`1 <= "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
-		{`1 <=> "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -229,6 +229,68 @@ func TestFloatConversions(t *testing.T) {
 	}
 }
 
+func TestFloatRoundingMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`1.2.ceil`, 2},
+		{`1.0.ceil`, 1},
+		{`(-1.2).ceil`, -1},
+		{`1.8.floor`, 1},
+		{`1.0.floor`, 1},
+		{`(-1.2).floor`, -2},
+		{`1.4.round`, 1},
+		{`1.5.round`, 2},
+		{`(-1.5).round`, -2},
+		{`(-1.4).round`, -1},
+		{`3.7.round.class.to_s`, "Integer"},
+		{`(5 / 2.0).floor`, 2},
+		{`2.5.round`, 2},
+		{`3.5.round`, 4},
+		{`(-2.5).round`, -2},
+		{`3.14159.round(2)`, 3.14},
+		{`3.14159.round(3)`, 3.142},
+		{`0.125.round(2)`, 0.12},
+		{`0.375.round(2)`, 0.38},
+		{`1234.5.round(-2)`, 1200},
+		{`1234.5.round(0).class.to_s`, "Integer"},
+		{`3.14159.floor(3)`, 3.141},
+		{`(-3.14159).floor(2)`, -3.15},
+		{`1.1.ceil(1)`, 1.1},
+		{`3.14159.ceil(2)`, 3.15},
+		{`(-3.14159).ceil(2)`, -3.14},
+		{`1.23.round(5)`, 1.23},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestFloatRoundingMethodsFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`1.5.ceil(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`1.5.floor(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`1.5.round(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`1.5.ceil(1.5)`, "TypeError: Expect argument to be Integer. got: Float", 1},
+		{`1.5.floor("1")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`1.5.round(nil)`, "TypeError: Expect argument to be Integer. got: Null", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestFloatEdgeCases(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -3,7 +3,6 @@ package vm
 import (
 	"bytes"
 	"fmt"
-	"reflect"
 	"sort"
 	"strings"
 
@@ -40,7 +39,7 @@ import (
 // - **value:** String literals and objects (Integer, String, Array, Hash, nil, etc) can be used.
 //
 // **Note:**
-// - The order of key-value pairs are **not** preserved.
+// - Key-value pairs are iterated in the order the keys were inserted.
 // - Operator `=>` is not supported.
 // - `Hash.new` is not supported.
 type HashObject struct {
@@ -49,6 +48,9 @@ type HashObject struct {
 
 	// See `[]` and `[]=` for the operational explanation of the default value.
 	Default Object
+
+	// order keeps the keys in insertion order. See `orderedKeys` for keys added to Pairs directly.
+	order []string
 }
 
 // Class methods --------------------------------------------------------
@@ -131,6 +133,10 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		// @return [Object] The value
 		Name: "[]=",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			// First arg is index
 			// Second arg is assigned value
 			if len(args) != 2 {
@@ -144,7 +150,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 			}
 
 			h := receiver.(*HashObject)
-			h.Pairs[key.value] = args[1]
+			h.set(key.value, args[1])
 
 			return args[1]
 
@@ -197,7 +203,8 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				t.callFrameStack.pop()
 			}
 
-			for stringKey, value := range hash.Pairs {
+			for _, stringKey := range hash.orderedKeys() {
+				value := hash.Pairs[stringKey]
 				objectKey := t.vm.InitStringObject(stringKey)
 				result := t.builtinMethodYield(blockFrame, objectKey, value)
 
@@ -238,6 +245,10 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		// @return [Hash]
 		Name: "clear",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 0 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
@@ -245,6 +256,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 			h := receiver.(*HashObject)
 
 			h.Pairs = make(map[string]Object)
+			h.order = nil
 
 			return h
 
@@ -294,6 +306,10 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		// @return [Object]
 		Name: "default=",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 1 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
 			}
@@ -315,17 +331,25 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Remove the key from the hash if key exist
+		// Removes the key from the hash and returns its value.
+		// If the key doesn't exist, returns nil, or the result of the block which takes the key if a block is given.
 		//
 		// ```Ruby
 		// h = { a: 1, b: 2, c: 3 }
-		// h.delete("b") # =>  { a: 1, c: 3 }
+		// h.delete("b") # => 2
+		// h             # => { a: 1, c: 3 }
+		// h.delete("z") # => nil
+		// h.delete("z") do |k| k + " not found" end # => "z not found"
 		// ```
 		//
 		// @param key [String]
-		// @return [Hash]
+		// @return [Object]
 		Name: "delete",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 1 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
 			}
@@ -338,11 +362,20 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, d.Class().Name)
 			}
 
-			deleteKeyValue := deleteKey.value
-			if _, ok := h.Pairs[deleteKeyValue]; ok {
-				delete(h.Pairs, deleteKeyValue)
+			value, ok := h.Pairs[deleteKey.value]
+			if ok {
+				h.delete(deleteKey.value)
+				// If there's nothing to yield, pop the block's call frame
+				if blockFrame != nil {
+					t.callFrameStack.pop()
+				}
+				return value
+			}
+
+			if blockFrame != nil {
+				return t.builtinMethodYield(blockFrame, deleteKey).Target
 			}
-			return h
+			return NULL
 
 		},
 	},
@@ -362,6 +395,10 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		// @return [Hash]
 		Name: "delete_if",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
 			if len(args) != 0 {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
@@ -379,9 +416,8 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				t.callFrameStack.pop()
 			}
 
-			// Note that from the Go specification, https://golang.org/ref/spec#For_statements,
-			// it's safe to delete elements from a Map, while iterating it.
-			for stringKey, value := range hash.Pairs {
+			for _, stringKey := range hash.orderedKeys() {
+				value := hash.Pairs[stringKey]
 				objectKey := t.vm.InitStringObject(stringKey)
 				result := t.builtinMethodYield(blockFrame, objectKey, value)
 
@@ -389,10 +425,10 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 
 				if isResultBoolean {
 					if booleanResult.value {
-						delete(hash.Pairs, stringKey)
+						hash.delete(stringKey)
 					}
 				} else if result.Target != NULL {
-					delete(hash.Pairs, stringKey)
+					hash.delete(stringKey)
 				}
 			}
 
@@ -433,7 +469,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Calls block once for each key in the hash (in sorted key order), passing the
+		// Calls block once for each key in the hash (in insertion order), passing the
 		// key-value pair as parameters.
 		// Returns `self`.
 		//
@@ -442,8 +478,8 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		// h.each do |k, v|
 		//   puts k.to_s + "->" + v.to_s
 		// end
-		// # => a->1
 		// # => b->2
+		// # => a->1
 		// ```
 		//
 		// @param block
@@ -463,7 +499,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 			if len(h.Pairs) == 0 {
 				t.callFrameStack.pop()
 			} else {
-				keys := h.sortedKeys()
+				keys := h.orderedKeys()
 
 				for _, k := range keys {
 					v := h.Pairs[k]
@@ -479,7 +515,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// Loops through keys of the hash with given block frame.
-		// Then returns an array of keys in alphabetical order.
+		// Then returns an array of keys in insertion order.
 		//
 		// ```Ruby
 		// h = { a: 1, b: "2", c: [1, 2, 3], d: { k: 'v' } }
@@ -510,7 +546,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				t.callFrameStack.pop()
 			}
 
-			keys := h.sortedKeys()
+			keys := h.orderedKeys()
 			var arrOfKeys []Object
 
 			for _, k := range keys {
@@ -525,7 +561,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// Loops through values of the hash with given block frame.
-		// Then returns an array of values of the hash in the alphabetical order of the keys.
+		// Then returns an array of values of the hash in the insertion order of the keys.
 		//
 		// ```Ruby
 		// h = { a: 1, b: "2", c: [1, 2, 3], d: { k: "v" } }
@@ -556,7 +592,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				t.callFrameStack.pop()
 			}
 
-			keys := h.sortedKeys()
+			keys := h.orderedKeys()
 			var arrOfValues []Object
 
 			for _, k := range keys {
@@ -611,7 +647,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 			c := args[0]
 			compare, ok := c.(*HashObject)
 
-			if ok && reflect.DeepEqual(h, compare) {
+			if ok && deepEqual(h, compare) {
 				return TRUE
 			}
 			return FALSE
@@ -622,7 +658,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		// Returns a value from the hash for the given key.
 		// If the key can’t be found, there are several options:
 		//
-		// - With no other arguments, it will raise an ArgumentError.
+		// - With no other arguments, it will raise a KeyError.
 		// - If a default value is given as a second argument, then that will be returned.
 		// - If an optional code block is specified, then runs the block and returns the result.
 		// - If a block and a second argument is given together, it raises an ArgumentError.
@@ -630,7 +666,8 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		// ```Ruby
 		// h = { spaghetti: "eat" }
 		// h.fetch("spaghetti")                     #=> "eat"
-		// h.fetch("pizza")                         #=> ArgumentError
+		// h.fetch("spaghetti", "not eat")          #=> "eat"
+		// h.fetch("pizza")                         #=> KeyError
 		// h.fetch("pizza", "not eat")              #=> "not eat"
 		// h.fetch("pizza") do |el| "eat " + el end #=> "eat pizza"
 		// ```
@@ -646,14 +683,11 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 
 			key, ok := args[0].(*StringObject)
 			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, key.Class().Name)
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
 			}
 
-			if aLen == 2 {
-				if blockFrame != nil {
-					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "The default argument can't be passed along with a block")
-				}
-				return args[1]
+			if aLen == 2 && blockFrame != nil {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "The default argument can't be passed along with a block")
 			}
 
 			hash := receiver.(*HashObject)
@@ -666,10 +700,14 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				return value
 			}
 
+			if aLen == 2 {
+				return args[1]
+			}
+
 			if blockFrame != nil {
 				return t.builtinMethodYield(blockFrame, key).Target
 			}
-			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "The value was not found, and no block has been provided")
+			return t.vm.InitErrorObject(errors.KeyError, sourceLine, errors.KeyNotFound, key.Inspect())
 
 		},
 	},
@@ -730,8 +768,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// Returns true if the specified key exists in the hash
-		// Currently, only string can be taken.
-		// type object.
+		// Currently, only string can be taken, as the keys of a hash are always strings.
 		//
 		// ```Ruby
 		// h = { a: 1, b: "2", c: [1, 2, 3], d: { k: "v" } }
@@ -745,22 +782,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		// @return [Boolean]
 		Name: "has_key?",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 1 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
-			}
-
-			h := receiver.(*HashObject)
-			i := args[0]
-			input, ok := i.(*StringObject)
-
-			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, i.Class().Name)
-			}
-
-			if _, ok := h.Pairs[input.value]; ok {
-				return TRUE
-			}
-			return FALSE
+			return receiver.(*HashObject).hasKey(t, args, sourceLine)
 
 		},
 	},
@@ -787,7 +809,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 			h := receiver.(*HashObject)
 
 			for _, v := range h.Pairs {
-				if reflect.DeepEqual(v, args[0]) {
+				if deepEqual(v, args[0]) {
 					return TRUE
 				}
 			}
@@ -796,14 +818,48 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns an array of keys (in arbitrary order)
+		// Returns true if the specified key exists in the hash. Same as `has_key?`.
 		//
 		// ```Ruby
-		// { a: 1, b: "2", c: [3, true, "Hello"] }.keys
-		// # =>  ["c", "b", "a"] or ["b", "a", "c"] ... etc
+		// h = { a: 1, b: "2" }
+		// h.include?("a") # => true
+		// h.include?("e") # => false
 		// ```
 		//
+		// @param key [String]
 		// @return [Boolean]
+		Name: "include?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*HashObject).hasKey(t, args, sourceLine)
+
+		},
+	},
+	{
+		// Returns true if the specified key exists in the hash. Same as `has_key?`.
+		//
+		// ```Ruby
+		// h = { a: 1, b: "2" }
+		// h.key?("a") # => true
+		// h.key?("e") # => false
+		// ```
+		//
+		// @param key [String]
+		// @return [Boolean]
+		Name: "key?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*HashObject).hasKey(t, args, sourceLine)
+
+		},
+	},
+	{
+		// Returns an array of keys in insertion order.
+		//
+		// ```Ruby
+		// { c: 1, a: "2", b: [3, true, "Hello"] }.keys
+		// # =>  ["c", "a", "b"]
+		// ```
+		//
+		// @return [Array]
 		Name: "keys",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			if len(args) != 0 {
@@ -812,7 +868,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 
 			h := receiver.(*HashObject)
 			var keys []Object
-			for k := range h.Pairs {
+			for _, k := range h.orderedKeys() {
 				keys = append(keys, t.vm.InitStringObject(k))
 			}
 			return t.vm.InitArrayObject(keys)
@@ -839,6 +895,54 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns an array of the results of running the block once for every key-value pair,
+		// in insertion order.
+		//
+		// ```Ruby
+		// h = { a: 1, b: 2 }
+		// h.map do |k, v|
+		//   k + v.to_s
+		// end
+		// # => ["a1", "b2"]
+		// ```
+		//
+		// @param block
+		// @return [Array]
+		Name: "map",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			h := receiver.(*HashObject)
+			var elements []Object
+
+			if blockIsEmpty(blockFrame) {
+				for range h.Pairs {
+					elements = append(elements, NULL)
+				}
+				return t.vm.InitArrayObject(elements)
+			}
+
+			// If there's nothing to yield, pop the block's call frame
+			if len(h.Pairs) == 0 {
+				t.callFrameStack.pop()
+			}
+
+			for _, k := range h.orderedKeys() {
+				result := t.builtinMethodYield(blockFrame, t.vm.InitStringObject(k), h.Pairs[k])
+				elements = append(elements, result.Target)
+			}
+
+			return t.vm.InitArrayObject(elements)
+
+		},
+	},
 	{
 		// Returns a new hash with the results of running the block once for every value.
 		// This method does not change the keys and the receiver hash values.
@@ -869,22 +973,24 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				return h
 			}
 
-			result := make(map[string]Object)
+			result := t.vm.InitHashObject(map[string]Object{})
 
 			if len(h.Pairs) == 0 {
 				t.callFrameStack.pop()
 			}
 
-			for k, v := range h.Pairs {
-				result[k] = t.builtinMethodYield(blockFrame, v).Target
+			for _, k := range h.orderedKeys() {
+				result.set(k, t.builtinMethodYield(blockFrame, h.Pairs[k]).Target)
 			}
-			return t.vm.InitHashObject(result)
+			return result
 
 		},
 	},
 	{
 		// Returns a newly merged hash. One or more hashes can be taken.
 		// If keys are duplicate between the receiver and the argument, the last ones in the argument are prioritized.
+		// If a block is given, the value of a duplicated key is the result of the block,
+		// which takes the key, the current value and the new value.
 		//
 		// ```Ruby
 		// h = { a: 1, b: "2", c: [1, 2, 3] }
@@ -893,33 +999,76 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		//
 		// { a: "Hello"}.merge({a: 0}, {a: 99})
 		// # => { a: 99 }
+		//
+		// { a: 1, b: 2 }.merge({ b: 3 }) do |key, old, new|
+		//   old + new
+		// end
+		// # => { a: 1, b: 5 }
 		// ```
 		//
 		// @param hash [Hash]
 		// @return [Hash]
 		Name: "merge",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) < 1 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentMore, 1, len(args))
+			h := receiver.(*HashObject)
+			result := t.vm.InitHashObject(map[string]Object{})
+			for _, k := range h.orderedKeys() {
+				result.set(k, h.Pairs[k])
 			}
 
-			h := receiver.(*HashObject)
-			result := make(map[string]Object)
-			for k, v := range h.Pairs {
-				result[k] = v
+			return result.merge(t, args, blockFrame, sourceLine)
+
+		},
+	},
+	{
+		// Merges the given hashes into self, like `merge` does, and returns self.
+		//
+		// ```Ruby
+		// h = { a: 1, b: 2 }
+		// h.merge!({ b: 3, c: 4 })
+		// h # => { a: 1, b: 3, c: 4 }
+		// ```
+		//
+		// @param hash [Hash]
+		// @return [Hash]
+		Name: "merge!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
 			}
 
-			for _, obj := range args {
-				hashObj, ok := obj.(*HashObject)
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.HashClass, obj.Class().Name)
-				}
-				for k, v := range hashObj.Pairs {
-					result[k] = v
-				}
+			return receiver.(*HashObject).merge(t, args, blockFrame, sourceLine)
+
+		},
+	},
+	{
+		// Returns a new hash consisting of entries for which the block returns false or nil.
+		// The opposite of `select`.
+		//
+		// ```ruby
+		// a = { a: 1, b: 2 }
+		//
+		// a.reject do |k, v|
+		//   v == 2
+		// end            # => { a: 1 }
+		// a.reject do |k, v|
+		//   nil
+		// end            # => { a: 1, b: 2 }
+		// ```
+		//
+		// @param block
+		// @return [Hash]
+		Name: "reject",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 			}
 
-			return t.vm.InitHashObject(result)
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			return receiver.(*HashObject).filter(t, blockFrame, false)
 
 		},
 	},
@@ -956,27 +1105,7 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
 			}
 
-			destinationPairs := map[string]Object{}
-			if blockIsEmpty(blockFrame) {
-				return t.vm.InitHashObject(destinationPairs)
-			}
-
-			sourceHash := receiver.(*HashObject)
-
-			if len(sourceHash.Pairs) == 0 {
-				t.callFrameStack.pop()
-			}
-
-			for stringKey, value := range sourceHash.Pairs {
-				objectKey := t.vm.InitStringObject(stringKey)
-				result := t.builtinMethodYield(blockFrame, objectKey, value)
-
-				if result.Target.isTruthy() {
-					destinationPairs[stringKey] = value
-				}
-			}
-
-			return t.vm.InitHashObject(destinationPairs)
+			return receiver.(*HashObject).filter(t, blockFrame, true)
 
 		},
 	},
@@ -1012,12 +1141,12 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns two-dimensional array with the key-value pairs of hash. If specified true
+		// Returns two-dimensional array with the key-value pairs of hash in insertion order. If specified true
 		// then it will return sorted key value pairs array
 		//
 		// ```Ruby
-		// { a: 1, b: 2, c: 3 }.to_a
-		// # => [["a", 1], ["c", 3], ["b", 2]] or [["b", 2], ["c", 3], ["a", 1]] ... etc
+		// { c: 3, a: 1, b: 2 }.to_a
+		// # => [["c", 3], ["a", 1], ["b", 2]]
 		// { a: 1, b: 2, c: 3 }.to_a(true)
 		// # => [["a", 1], ["b", 2], ["c", 3]]
 		// { b: 1, a: 2, c: 3 }.to_a(true)
@@ -1057,10 +1186,10 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 					resultArr = append(resultArr, t.vm.InitArrayObject(pairArr))
 				}
 			} else {
-				for k, v := range h.Pairs {
+				for _, k := range h.orderedKeys() {
 					var pairArr []Object
 					pairArr = append(pairArr, t.vm.InitStringObject(k))
-					pairArr = append(pairArr, v)
+					pairArr = append(pairArr, h.Pairs[k])
 					resultArr = append(resultArr, t.vm.InitArrayObject(pairArr))
 				}
 			}
@@ -1142,22 +1271,42 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 				t.callFrameStack.pop()
 			}
 
-			resultHash := make(map[string]Object)
-			for k, v := range h.Pairs {
-				result := t.builtinMethodYield(blockFrame, v)
-				resultHash[k] = result.Target
+			resultHash := t.vm.InitHashObject(map[string]Object{})
+			for _, k := range h.orderedKeys() {
+				result := t.builtinMethodYield(blockFrame, h.Pairs[k])
+				resultHash.set(k, result.Target)
+			}
+			return resultHash
+
+		},
+	},
+	{
+		// Merges the given hashes into self and returns self. Same as `merge!`.
+		//
+		// ```Ruby
+		// h = { a: 1 }
+		// h.update({ a: 2, b: 3 })
+		// h # => { a: 2, b: 3 }
+		// ```
+		//
+		// @param hash [Hash]
+		// @return [Hash]
+		Name: "update",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
 			}
-			return t.vm.InitHashObject(resultHash)
+
+			return receiver.(*HashObject).merge(t, args, blockFrame, sourceLine)
 
 		},
 	},
 	{
-		// Returns an array of values.
-		// The order of the returned values are indeterminable.
+		// Returns an array of values in the insertion order of the keys.
 		//
 		// ```Ruby
-		// { a: 1, b: "2", c: [3, true, "Hello"] }.keys
-		// # =>  [1, "2", [3, true, "Hello"]] or ["2", [3, true, "Hello"], 1] ... etc
+		// { c: 1, a: "2", b: [3, true, "Hello"] }.values
+		// # =>  [1, "2", [3, true, "Hello"]]
 		// ```
 		//
 		// @return [Array]
@@ -1168,11 +1317,11 @@ var builtinHashInstanceMethods = []*BuiltinMethodObject{
 			}
 
 			h := receiver.(*HashObject)
-			var keys []Object
-			for _, v := range h.Pairs {
-				keys = append(keys, v)
+			var values []Object
+			for _, k := range h.orderedKeys() {
+				values = append(values, h.Pairs[k])
 			}
-			return t.vm.InitArrayObject(keys)
+			return t.vm.InitArrayObject(values)
 
 		},
 	},
@@ -1242,7 +1391,7 @@ func (h *HashObject) ToString() string {
 	var out bytes.Buffer
 	var pairs []string
 
-	for _, key := range h.sortedKeys() {
+	for _, key := range h.orderedKeys() {
 		pairs = append(pairs, fmt.Sprintf("%s: %s", key, h.Pairs[key].Inspect()))
 	}
 
@@ -1289,6 +1438,139 @@ func (h *HashObject) sortedKeys() []string {
 	return arr
 }
 
+// Returns the keys of the hash in insertion order.
+// Keys that were put into Pairs directly (e.g. hashes built from a Go map) follow in alphabetical order.
+func (h *HashObject) orderedKeys() []string {
+	keys := make([]string, 0, len(h.Pairs))
+	seen := make(map[string]bool, len(h.Pairs))
+	for _, k := range h.order {
+		if _, ok := h.Pairs[k]; ok && !seen[k] {
+			keys = append(keys, k)
+			seen[k] = true
+		}
+	}
+
+	if len(keys) < len(h.Pairs) {
+		var rest []string
+		for k := range h.Pairs {
+			if !seen[k] {
+				rest = append(rest, k)
+			}
+		}
+		sort.Strings(rest)
+		keys = append(keys, rest...)
+	}
+
+	return keys
+}
+
+// Sets the value of the key, appending the key to the insertion order if it's new
+func (h *HashObject) set(key string, value Object) {
+	if _, ok := h.Pairs[key]; !ok {
+		h.order = append(h.order, key)
+	}
+	h.Pairs[key] = value
+}
+
+// Deletes the key and its value from the hash
+func (h *HashObject) delete(key string) {
+	if _, ok := h.Pairs[key]; !ok {
+		return
+	}
+
+	delete(h.Pairs, key)
+	for i, k := range h.order {
+		if k == key {
+			h.order = append(h.order[:i:i], h.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// Returns true if the key exists in the hash; common to `has_key?`, `include?` and `key?`.
+func (h *HashObject) hasKey(t *Thread, args []Object, sourceLine int) Object {
+	if len(args) != 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+	}
+
+	input, ok := args[0].(*StringObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+	}
+
+	if _, ok := h.Pairs[input.value]; ok {
+		return TRUE
+	}
+	return FALSE
+}
+
+// Returns a new hash of the pairs for which the block's truthiness matches `selecting`;
+// common to `select` and `reject`.
+func (h *HashObject) filter(t *Thread, blockFrame *normalCallFrame, selecting bool) Object {
+	destination := t.vm.InitHashObject(map[string]Object{})
+
+	// An empty block returns nil, which selects nothing and rejects nothing
+	if blockIsEmpty(blockFrame) {
+		if !selecting {
+			for _, k := range h.orderedKeys() {
+				destination.set(k, h.Pairs[k])
+			}
+		}
+		return destination
+	}
+
+	if len(h.Pairs) == 0 {
+		t.callFrameStack.pop()
+	}
+
+	for _, stringKey := range h.orderedKeys() {
+		value := h.Pairs[stringKey]
+		objectKey := t.vm.InitStringObject(stringKey)
+		result := t.builtinMethodYield(blockFrame, objectKey, value)
+
+		if result.Target.isTruthy() == selecting {
+			destination.set(stringKey, value)
+		}
+	}
+
+	return destination
+}
+
+// Merges the hashes into self in order, resolving duplicated keys with the block if given;
+// common to `merge`, `merge!` and `update`.
+func (h *HashObject) merge(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int) Object {
+	if len(args) < 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentMore, 1, len(args))
+	}
+
+	// Check all arguments before merging so a failed `merge!` leaves self untouched
+	for _, obj := range args {
+		if _, ok := obj.(*HashObject); !ok {
+			return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.HashClass, obj.Class().Name)
+		}
+	}
+
+	var yielded bool
+	for _, obj := range args {
+		hashObj := obj.(*HashObject)
+		for _, k := range hashObj.orderedKeys() {
+			value := hashObj.Pairs[k]
+			if current, ok := h.Pairs[k]; ok && blockFrame != nil {
+				value = t.builtinMethodYield(blockFrame, t.vm.InitStringObject(k), current, value).Target
+				yielded = true
+			}
+			h.set(k, value)
+		}
+	}
+
+	// If there's nothing to yield, pop the block's call frame
+	if blockFrame != nil && !yielded {
+		t.callFrameStack.pop()
+	}
+
+	return h
+}
+
 // Returns the duplicate of the Hash object
 func (h *HashObject) copy() Object {
 	elems := map[string]Object{}
@@ -1300,6 +1582,7 @@ func (h *HashObject) copy() Object {
 	newHash := &HashObject{
 		BaseObj: &BaseObj{class: h.class, InstanceVariables: newEnvironment()},
 		Pairs:   elems,
+		order:   h.orderedKeys(),
 	}
 
 	return newHash
@@ -1321,7 +1604,7 @@ func (h *HashObject) dig(t *Thread, keys []Object, sourceLine int) Object {
 		return NULL
 	}
 
-	if len(nextKeys) == 0 {
+	if len(nextKeys) == 0 || currentValue == NULL {
 		return currentValue
 	}
 
@@ -3,7 +3,6 @@ package vm
 import (
 	"encoding/json"
 	"reflect"
-	"sort"
 	"testing"
 )
 
@@ -407,41 +406,73 @@ func TestHashDeleteMethod(t *testing.T) {
 		expected interface{}
 	}{
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("a")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("a")
 		h["a"]
 		`, nil},
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("a")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("a")
 		h["b"]
 		`, "Hello"},
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("a")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("a")
 		h["c"]
 		`, true},
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("b")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("b")
 		h["a"]
 		`, 1},
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("b")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("b")
 		h["b"]
 		`, nil},
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("b")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("b")
 		h["c"]
 		`, true},
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("c")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("c")
 		h["a"]
 		`, 1},
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("c")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("c")
 		h["b"]
 		`, "Hello"},
 		{`
-		h = { a: 1, b: "Hello", c: true }.delete("c")
+		h = { a: 1, b: "Hello", c: true }
+		h.delete("c")
 		h["c"]
 		`, nil},
+		{`{ a: 1, b: "Hello", c: true }.delete("b")`, "Hello"},
+		{`{ a: 1, b: "Hello", c: true }.delete("z")`, nil},
+		{`{ a: nil }.delete("a")`, nil},
+		{`
+		h = { a: 1 }
+		h.delete("z")
+		h.length
+		`, 1},
+		{`
+		{ a: 1 }.delete("z") do |k|
+		  k + " not found"
+		end
+		`, "z not found"},
+		{`
+		{ a: 1 }.delete("a") do |k|
+		  k + " not found"
+		end
+		`, 1},
+		{`
+		h = { a: 1, b: 2 }
+		h.delete("a") do |k| 0 end
+		h.to_s
+		`, "{ b: 2 }"},
 	}
 
 	for i, tt := range tests {
@@ -551,6 +582,9 @@ func TestHashDigMethod(t *testing.T) {
 		{`
 			{ a: {}, b: 2 }.dig(:a, :b, :c)
 		`, nil},
+		{`
+			{ a: nil, b: 2 }.dig(:a, :b)
+		`, nil},
 	}
 
 	for i, tt := range tests {
@@ -612,7 +646,18 @@ func TestHashEachMethod(t *testing.T) {
 				output.push([k, v])
 			end
 			output
-		`, [][]interface{}{{"a", 1}, {"b", "2"}}},
+		`, [][]interface{}{{"b", "2"}, {"a", 1}}},
+		{`
+			output = []
+			h = { b: "2", a: 1 }
+			h["c"] = 3
+			h.delete("b")
+			h["b"] = 4
+			h.each do |k, v|
+				output.push([k, v])
+			end
+			output
+		`, [][]interface{}{{"a", 1}, {"c", 3}, {"b", 4}}},
 	}
 
 	for i, tt := range tests2 {
@@ -647,7 +692,7 @@ func TestHashEachKeyMethod(t *testing.T) {
 	}{
 		{`
 			{ b: "Hello", c: "World", a: "Goby" }.each_key do end
-		`, []interface{}{"a", "b", "c"}},
+		`, []interface{}{"b", "c", "a"}},
 		{`
 			{ a: "Hello", b: "World", c: "Goby" }.each_key do |key|
 				# Empty Block
@@ -657,7 +702,7 @@ func TestHashEachKeyMethod(t *testing.T) {
 			{ b: "Hello", c: "World", a: "Goby" }.each_key do
 				# Empty Block
 			end
-		`, []interface{}{"a", "b", "c"}},
+		`, []interface{}{"b", "c", "a"}},
 		{`
 			{ b: "Hello", c: "World", b: "Goby" }.each_key do |key|
 				# Empty Block
@@ -723,7 +768,7 @@ func TestHashEachValueMethod(t *testing.T) {
 			{ b: "Hello", c: 123, a: true }.each_value do |v|
 				# Empty Block
 			end
-		`, []interface{}{true, "Hello", 123}},
+		`, []interface{}{"Hello", 123, true}},
 		{`
 			{ a: "Hello", b: 123, a: true }.each_value do |v|
 				# Empty Block
@@ -812,6 +857,12 @@ func TestHashEmptyMethod(t *testing.T) {
 	}{
 		{`{}.empty?`, true},
 		{`{ a: "Hello" }.empty?`, false},
+		{`{ a: nil }.empty?`, false},
+		{`
+		h = { a: 1 }
+		h.delete(:a)
+		h.empty?
+		`, true},
 	}
 
 	for i, tt := range tests {
@@ -895,6 +946,15 @@ func TestHashFetchMethod(t *testing.T) {
 		{`
 			{ spaghetti: "eat" }.fetch("pizza") do |el| "eat " + el end
 		`, "eat pizza"},
+		{`
+			{ spaghetti: "eat" }.fetch("spaghetti", "not eat")
+		`, "eat"},
+		{`
+			{ spaghetti: "eat" }.fetch("spaghetti") do |el| "don't eat " + el end
+		`, "eat"},
+		{`
+			{ spaghetti: nil }.fetch("spaghetti", "not eat").to_s
+		`, ""},
 	}
 
 	for i, tt := range tests {
@@ -911,7 +971,8 @@ func TestHashFetchMethodFail(t *testing.T) {
 		{`{ spaghetti: "eat" }.fetch()`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
 		{`{ spaghetti: "eat" }.fetch("a", "b", "c")`, "ArgumentError: Expect 1 to 2 argument(s). got: 3", 1},
 		{`{ spaghetti: "eat" }.fetch("a", "b") do end`, "ArgumentError: The default argument can't be passed along with a block", 1},
-		{`{ spaghetti: "eat" }.fetch("pizza")`, "ArgumentError: The value was not found, and no block has been provided", 1},
+		{`{ spaghetti: "eat" }.fetch("pizza")`, "KeyError: Key not found: \"pizza\"", 1},
+		{`{ spaghetti: "eat" }.fetch(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -970,6 +1031,15 @@ func TestHashHasKeyMethod(t *testing.T) {
 		{`{ a: "Hello", b: 123, c: true }.has_key?("d")`, false},
 		{`{ a: "Hello", b: 123, c: true }.has_key?(:a)`, true},
 		{`{ a: "Hello", b: 123, c: true }.has_key?(:d)`, false},
+		{`{ a: "Hello", b: 123, c: true }.key?("a")`, true},
+		{`{ a: "Hello", b: 123, c: true }.key?("d")`, false},
+		{`{ a: "Hello", b: 123, c: true }.include?("b")`, true},
+		{`{ a: "Hello", b: 123, c: true }.include?("d")`, false},
+		{`
+		h = { a: 1 }
+		h.delete("a")
+		h.has_key?("a")
+		`, false},
 	}
 
 	for i, tt := range tests {
@@ -987,6 +1057,10 @@ func TestHashHasKeyMethodFail(t *testing.T) {
 		{`{ a: 1, b: 2 }.has_key?(true, { hello: "World" })`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
 		{`{ a: 1, b: 2 }.has_key?(true)`, "TypeError: Expect argument to be String. got: Boolean", 1},
 		{`{ a: 1, b: 2 }.has_key?(123)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`{ a: 1, b: 2 }.key?`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`{ a: 1, b: 2 }.key?(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`{ a: 1, b: 2 }.include?("a", "b")`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`{ a: 1, b: 2 }.include?(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1037,31 +1111,34 @@ func TestHashHasValueMethodFail(t *testing.T) {
 }
 
 func TestHashKeysMethod(t *testing.T) {
-	input := `
-	{ foo: 123, bar: "test", baz: true }.keys
-	`
-
-	v := initTestVM()
-	evaluated := v.testEval(t, input, getFilename())
-
-	arr, ok := evaluated.(*ArrayObject)
-	if !ok {
-		t.Fatalf("Expect evaluated value to be Array. got: %T", evaluated)
-	} else if arr.Len() != 3 {
-		t.Fatalf("Expect evaluated array length to be 3. got: %d", arr.Len())
+	tests := []struct {
+		input    string
+		expected []interface{}
+	}{
+		{`{ foo: 123, bar: "test", baz: true }.keys`, []interface{}{"foo", "bar", "baz"}},
+		{`{ b: 1, a: 2, b: 3 }.keys`, []interface{}{"b", "a"}},
+		{`
+		h = { b: 1, a: 2 }
+		h["c"] = 3
+		h["b"] = 4
+		h.keys
+		`, []interface{}{"b", "a", "c"}},
+		{`
+		h = { b: 1, a: 2 }
+		h.delete("b")
+		h["b"] = 3
+		h.keys
+		`, []interface{}{"a", "b"}},
+		{`{}.keys`, []interface{}{}},
 	}
 
-	var evaluatedArr []string
-	for _, k := range arr.Elements {
-		evaluatedArr = append(evaluatedArr, k.(*StringObject).value)
-	}
-	sort.Strings(evaluatedArr)
-	if !reflect.DeepEqual(evaluatedArr, []string{"bar", "baz", "foo"}) {
-		t.Fatalf("Expect evaluated array to be [\"bar\", \"baz\", \"foo\". got: %v", evaluatedArr)
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
 	}
-
-	v.checkCFP(t, 0, 0)
-	v.checkSP(t, 0, 1)
 }
 
 func TestHashKeysMethodFail(t *testing.T) {
@@ -1116,6 +1193,55 @@ func TestHashLengthMethodFail(t *testing.T) {
 	}
 }
 
+func TestHashMapMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []interface{}
+	}{
+		{`
+			{ b: 2, a: 1 }.map do |k, v|
+			  k + v.to_s
+			end
+		`, []interface{}{"b2", "a1"}},
+		{`
+			{ a: 1, b: 2 }.map do |k, v|
+			  v * 10
+			end
+		`, []interface{}{10, 20}},
+		{`
+			{ a: 1, b: 2 }.map do end
+		`, []interface{}{nil, nil}},
+		{`
+			{}.map do |k, v|
+			  v
+			end
+		`, []interface{}{}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestHashMapMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`{ a: 1 }.map(1) do end`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`{ a: 1 }.map`, "InternalError: Can't yield without a block", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestHashMapValuesMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1240,6 +1366,47 @@ func TestHashMergeMethod(t *testing.T) {
 	}
 }
 
+func TestHashMergeMethodWithBlock(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		{ a: 1, b: 2 }.merge({ b: 3, c: 4 }) do |key, old, new|
+		  old + new
+		end.to_s
+		`, "{ a: 1, b: 5, c: 4 }"},
+		{`
+		{ a: 1, b: 2 }.merge({ b: 3 }, { b: 4 }) do |key, old, new|
+		  old + new
+		end.to_s
+		`, "{ a: 1, b: 9 }"},
+		{`
+		{ a: 1, b: 2 }.merge({ b: 3 }) do |key, old, new|
+		  key
+		end.to_s
+		`, `{ a: 1, b: "b" }`},
+		{`
+		{ a: 1 }.merge({ b: 2 }) do |key, old, new|
+		  0
+		end.to_s
+		`, "{ a: 1, b: 2 }"},
+		{`
+		h = { a: 1 }
+		h.merge({ a: 2 })
+		h.to_s
+		`, "{ a: 1 }"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestHashMergeMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`{ a: 1, b: 2 }.merge`, "ArgumentError: Expect 1 or more argument(s). got: 0", 1},
@@ -1256,6 +1423,125 @@ func TestHashMergeMethodFail(t *testing.T) {
 	}
 }
 
+func TestHashMergeBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		h = { a: 1, b: 2 }
+		h.merge!({ b: 3, c: 4 })
+		h.to_s
+		`, "{ a: 1, b: 3, c: 4 }"},
+		{`
+		h = { a: 1, b: 2 }
+		h.merge!({ b: 3 }) do |key, old, new|
+		  old * 10 + new
+		end
+		h.to_s
+		`, "{ a: 1, b: 23 }"},
+		{`
+		h = { a: 1 }
+		h.update({ a: 2 }, { b: 3 })
+		h.to_s
+		`, "{ a: 2, b: 3 }"},
+		{`
+		h = { a: 1 }
+		h.update({ a: 2 }) do |key, old, new|
+		  old - new
+		end
+		h["a"]
+		`, -1},
+		{`
+		h = { a: 1 }
+		h.merge!({ a: 2 }).object_id == h.object_id
+		`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestHashMergeBangMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`{ a: 1, b: 2 }.merge!`, "ArgumentError: Expect 1 or more argument(s). got: 0", 1},
+		{`{ a: 1, b: 2 }.update`, "ArgumentError: Expect 1 or more argument(s). got: 0", 1},
+		{`{ a: 1, b: 2 }.merge!({ hello: "World" }, 123)`, "TypeError: Expect argument to be Hash. got: Integer", 1},
+		{`{ a: 1, b: 2 }.update("Hello")`, "TypeError: Expect argument to be Hash. got: String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestHashRejectMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected map[string]interface{}
+	}{
+		{`
+			{ a: 1, b: 2 }.reject do |k, v|
+			  v == 2
+			end
+		`, map[string]interface{}{"a": 1}},
+		{`
+			{ a: 1, b: 2 }.reject do |k, v|
+			  5
+			end
+		`, map[string]interface{}{}},
+		{`
+			{ a: 1, b: 2 }.reject do |k, v|
+			  nil
+			end
+		`, map[string]interface{}{"a": 1, "b": 2}},
+		{`
+			{ a: 1, b: 2 }.reject do end
+		`, map[string]interface{}{"a": 1, "b": 2}},
+		{`
+			{ }.reject do |k, v| true end
+		`, map[string]interface{}{}},
+		// non-destructivity specification
+		{`
+			source = { a: 1, b: 2 }
+			source.reject do |k, v| true end
+			source
+		`, map[string]interface{}{"a": 1, "b": 2}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyHashObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestHashRejectMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`{ }.reject(123) do end`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`{ }.reject`, "InternalError: Can't yield without a block", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestHashSelectMethod(t *testing.T) {
 	testsSortedArray := []struct {
 		input    string
@@ -1693,6 +1979,8 @@ func TestHashToStringMethod(t *testing.T) {
 		{`{ a: 1 }.to_s`, "{ a: 1 }"},
 		{`{ a: 1, b: "Hello" }.to_s`, "{ a: 1, b: \"Hello\" }"},
 		{`{ a: 1, b: [1, true, "Hello", 1..2], c: { lang: "Goby" } }.to_s`, "{ a: 1, b: [1, true, \"Hello\", (1..2)], c: { lang: \"Goby\" } }"},
+		{`{ c: 1, a: 2, b: 3 }.to_s`, "{ c: 1, a: 2, b: 3 }"},
+		{`{ c: 1, a: 2 }.merge({ b: 3, c: 4 }).to_s`, "{ c: 4, a: 2, b: 3 }"},
 	}
 
 	for i, tt := range tests {
@@ -1803,34 +2091,27 @@ func TestHashTransformValuesMethodFail(t *testing.T) {
 }
 
 func TestHashValuesMethod(t *testing.T) {
-	input := `
-	{ a: 123, b: "test", c: true, d: [1, "Goby", false] }.values
-	`
-
-	v := initTestVM()
-	evaluated := v.testEval(t, input, getFilename())
-
-	arr, ok := evaluated.(*ArrayObject)
-	if !ok {
-		t.Fatalf("Expect evaluated value to be Array. got: %T", evaluated)
-	} else if arr.Len() != 4 {
-		t.Fatalf("Expect evaluated array length to be 4. got: %d", arr.Len())
+	tests := []struct {
+		input    string
+		expected []interface{}
+	}{
+		{`{ d: 123, b: "test", c: true, a: [1, "Goby", false] }.values`, []interface{}{123, "test", true, []interface{}{1, "Goby", false}}},
+		{`
+		h = { b: 1, a: 2 }
+		h["c"] = 3
+		h["b"] = 4
+		h.values
+		`, []interface{}{4, 2, 3}},
+		{`{}.values`, []interface{}{}},
 	}
 
-	for _, v := range arr.Elements {
-		switch value := v.(type) {
-		case *IntegerObject:
-			verifyIntegerObject(t, 0, value, 123)
-		case *StringObject:
-			verifyStringObject(t, 0, v, "test")
-		case *BooleanObject:
-			verifyBooleanObject(t, 0, v, true)
-		case *ArrayObject:
-			verifyArrayObject(t, 0, v, []interface{}{1, "Goby", false})
-		}
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		verifyArrayObject(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
 	}
-	v.checkCFP(t, 0, 0)
-	v.checkSP(t, 0, 1)
 }
 
 func TestHashValuesMethodFail(t *testing.T) {
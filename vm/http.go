@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/goby-lang/goby/vm/errors"
 )
@@ -14,6 +15,7 @@ const (
 	invalidSplatArgument    = "Splat arguments must be a string, got: %s on argument #%d"
 	couldNotCompleteRequest = "Could not complete request, %s"
 	non200Response          = "Non-200 response, %s (%d)"
+	requestTimedOut         = "Request timed out after %s"
 )
 
 var (
@@ -49,7 +51,7 @@ var builtinHTTPClassMethods = []*BuiltinMethodObject{
 				uri.Path = path.Join(arr...)
 			}
 
-			resp, err := http.Get(uri.String())
+			resp, err := t.vm.httpClient(0).Get(uri.String())
 			if err != nil {
 				return t.vm.InitErrorObject(errors.HTTPError, sourceLine, couldNotCompleteRequest, err)
 			}
@@ -93,7 +95,7 @@ var builtinHTTPClassMethods = []*BuiltinMethodObject{
 			}
 			body := arg2.value
 
-			resp, err := http.Post(host, contentType, strings.NewReader(body))
+			resp, err := t.vm.httpClient(0).Post(host, contentType, strings.NewReader(body))
 			if err != nil {
 				return t.vm.InitErrorObject(errors.HTTPError, sourceLine, couldNotCompleteRequest, err)
 			}
@@ -136,7 +138,7 @@ var builtinHTTPClassMethods = []*BuiltinMethodObject{
 				uri.Path = path.Join(arr...)
 			}
 
-			resp, err := http.Head(uri.String())
+			resp, err := t.vm.httpClient(0).Head(uri.String())
 			if err != nil {
 				return t.vm.InitErrorObject(errors.HTTPError, sourceLine, couldNotCompleteRequest, err)
 			}
@@ -215,3 +217,27 @@ func initResponseClass(vm *VM, hc *RClass) *RClass {
 	httpResponseClass = responseClass
 	return responseClass
 }
+
+// Other helper functions -----------------------------------------------
+
+// httpClient returns a Go HTTP client which sends requests with the VM's transport; a zero timeout means no timeout
+func (vm *VM) httpClient(timeout time.Duration) *http.Client {
+	return &http.Client{Transport: vm.httpTransport, Timeout: timeout}
+}
+
+// initHeadersObject returns a hash of the headers; each value is an array so repeated headers like Set-Cookie are kept
+func (vm *VM) initHeadersObject(header http.Header) *HashObject {
+	headers := map[string]Object{}
+
+	for k, v := range header {
+		var values []Object
+
+		for _, value := range v {
+			values = append(values, vm.InitStringObject(value))
+		}
+
+		headers[k] = vm.InitArrayObject(values)
+	}
+
+	return vm.InitHashObject(headers)
+}
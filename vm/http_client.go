@@ -2,9 +2,12 @@ package vm
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/goby-lang/goby/vm/classes"
 	"github.com/goby-lang/goby/vm/errors"
@@ -13,98 +16,81 @@ import (
 // Instance methods --------------------------------------------------------
 
 func builtinHTTPClientInstanceMethods() []*BuiltinMethodObject {
-	//TODO: cookie jar and mutable client
-	goClient := http.DefaultClient
-
+	//TODO: cookie jar
 	return []*BuiltinMethodObject{
 		{
 			// Sends a GET request to the target and returns a `Net::HTTP::Response` object.
+			// The request headers can be given as a hash.
+			//
+			// ```ruby
+			// headers = {}
+			// headers["Accept"] = "text/html"
+			//
+			// Net::HTTP.start do |client|
+			//   res = client.get("https://example.com", headers)
+			//   res.status_code #=> 200
+			// end
+			// ```
+			//
+			// @param url [String], headers [Hash]
+			// @return [Net::HTTP::Response]
 			Name: "get",
 			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-				if len(args) != 1 {
-					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
-				}
-
-				u, ok := args[0].(*StringObject)
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, u.Class().Name)
-				}
-
-				resp, err := goClient.Get(u.value)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.HTTPError, sourceLine, couldNotCompleteRequest, err)
-				}
-
-				gobyResp, err := responseGoToGoby(t, resp)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.InternalError, sourceLine, err.Error())
+				if len(args) < 1 || len(args) > 2 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, len(args))
 				}
 
-				return gobyResp
+				return sendRequest(t, receiver, http.MethodGet, args[0], "", nil, args[1:], sourceLine)
 
 			},
 		}, {
-			// Sends a POST request to the target and returns a `Net::HTTP::Response` object.
+			// Sends a POST request to the target with the content type and the body, and returns a `Net::HTTP::Response` object.
+			// The request headers can be given as a hash.
+			//
+			// ```ruby
+			// headers = {}
+			// headers["X-Token"] = "secret"
+			//
+			// Net::HTTP.start do |client|
+			//   res = client.post("https://example.com", "application/json", "{}", headers)
+			//   res.body
+			// end
+			// ```
+			//
+			// @param url [String], content type [String], body [String], headers [Hash]
+			// @return [Net::HTTP::Response]
 			Name: "post",
 			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-				if len(args) != 3 {
-					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 3, len(args))
-				}
-
-				u, ok := args[0].(*StringObject)
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, u.Class().Name)
+				if len(args) < 3 || len(args) > 4 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 3, 4, len(args))
 				}
 
 				contentType, ok := args[1].(*StringObject)
 				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, u.Class().Name)
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[1].Class().Name)
 				}
 
 				body, ok := args[2].(*StringObject)
 				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, u.Class().Name)
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[2].Class().Name)
 				}
 
-				bodyR := strings.NewReader(body.value)
-
-				resp, err := goClient.Post(u.value, contentType.value, bodyR)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.HTTPError, sourceLine, "Could not complete request, %s", err)
-				}
-
-				gobyResp, err := responseGoToGoby(t, resp)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.InternalError, sourceLine, err.Error())
-				}
-
-				return gobyResp
+				return sendRequest(t, receiver, http.MethodPost, args[0], contentType.value, strings.NewReader(body.value), args[3:], sourceLine)
 
 			},
 		}, {
 			// Sends a HEAD request to the target and returns a `Net::HTTP::Response` object.
+			// The request headers can be given as a hash.
+			//
+			// @param url [String], headers [Hash]
+			// @return [Net::HTTP::Response]
 			Name: "head",
 			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-				if len(args) != 1 {
-					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
-				}
-
-				u, ok := args[0].(*StringObject)
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, u.Class().Name)
-				}
-
-				resp, err := goClient.Head(u.value)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.HTTPError, sourceLine, couldNotCompleteRequest, err)
-				}
-
-				gobyResp, err := responseGoToGoby(t, resp)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.InternalError, sourceLine, err.Error())
+				if len(args) < 1 || len(args) > 2 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, len(args))
 				}
 
-				return gobyResp
+				return sendRequest(t, receiver, http.MethodHead, args[0], "", nil, args[1:], sourceLine)
 
 			},
 		}, {
@@ -115,7 +101,21 @@ func builtinHTTPClientInstanceMethods() []*BuiltinMethodObject {
 
 			},
 		}, {
-			// Sends a passed `Net::HTTP::Request` object and returns a `Net::HTTP::Response` object
+			// Sends a passed `Net::HTTP::Request` object and returns a `Net::HTTP::Response` object.
+			// The headers set with `Request#set_header` are sent as well.
+			//
+			// ```ruby
+			// Net::HTTP.start do |client|
+			//   r = client.request
+			//   r.url = "https://example.com"
+			//   r.method = "GET"
+			//   r.set_header("Accept", "text/html")
+			//   client.exec(r)
+			// end
+			// ```
+			//
+			// @param request [Net::HTTP::Request]
+			// @return [Net::HTTP::Response]
 			Name: "exec",
 			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 				if len(args) != 1 {
@@ -131,18 +131,55 @@ func builtinHTTPClientInstanceMethods() []*BuiltinMethodObject {
 					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, err.Error())
 				}
 
-				goResp, err := goClient.Do(goReq)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.HTTPError, sourceLine, couldNotCompleteRequest, err)
+				return doRequest(t, receiver, goReq, sourceLine)
+
+			},
+		}, {
+			// Returns the timeout of the requests in seconds, or nil if the requests never time out.
+			//
+			// @return [Numeric]
+			Name: "timeout",
+			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+				if len(args) != 0 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
 				}
 
-				gobyResp, err := responseGoToGoby(t, goResp)
+				timeout, ok := receiver.InstanceVariableGet("@timeout")
+				if !ok {
+					return NULL
+				}
 
-				if err != nil {
-					return t.vm.InitErrorObject(errors.InternalError, sourceLine, err.Error())
+				return timeout
+
+			},
+		}, {
+			// Sets the timeout of the requests in seconds. A request which takes longer raises an `HTTPError`.
+			// Setting 0 or nil means the requests never time out, which is the default.
+			//
+			// ```ruby
+			// Net::HTTP.start do |client|
+			//   client.timeout = 0.5
+			//   client.get("https://example.com")
+			// end
+			// ```
+			//
+			// @param seconds [Numeric]
+			// @return [Numeric]
+			Name: "timeout=",
+			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+				if len(args) != 1 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+				}
+
+				switch args[0].(type) {
+				case *IntegerObject, *FloatObject, *NullObject:
+				default:
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
 				}
 
-				return gobyResp
+				receiver.InstanceVariableSet("@timeout", args[0])
+
+				return args[0]
 
 			},
 		},
@@ -191,7 +228,18 @@ func requestGobyToGo(gobyReq Object) (*http.Request, error) {
 		body = bodyObj.(*StringObject).value
 	}
 
-	return http.NewRequest(method, u, strings.NewReader(body))
+	goReq, err := http.NewRequest(method, u, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if headersObj, ok := gobyReq.InstanceVariableGet("@headers"); ok {
+		if headers, ok := headersObj.(*HashObject); ok {
+			setHeaders(goReq.Header, headers)
+		}
+	}
+
+	return goReq, nil
 
 }
 
@@ -202,6 +250,7 @@ func responseGoToGoby(t *Thread, goResp *http.Response) (Object, error) {
 	//attr_reader :headers
 
 	body, err := ioutil.ReadAll(goResp.Body)
+	goResp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
@@ -212,13 +261,83 @@ func responseGoToGoby(t *Thread, goResp *http.Response) (Object, error) {
 	gobyResp.InstanceVariableSet("@protocol", t.vm.InitObjectFromGoType(goResp.Proto))
 	gobyResp.InstanceVariableSet("@transfer_encoding", t.vm.InitObjectFromGoType(goResp.TransferEncoding))
 
-	underHeaders := map[string]Object{}
+	gobyResp.InstanceVariableSet("@headers", t.vm.initHeadersObject(goResp.Header))
 
-	for k, v := range goResp.Header {
-		underHeaders[k] = t.vm.InitObjectFromGoType(v)
+	return gobyResp, nil
+}
+
+// clientGobyToGo returns a Go HTTP client with the timeout of the `Net::HTTP::Client` object
+func clientGobyToGo(t *Thread, gobyClient Object) *http.Client {
+	var timeout time.Duration
+
+	if timeoutObj, ok := gobyClient.InstanceVariableGet("@timeout"); ok {
+		switch seconds := timeoutObj.(type) {
+		case *IntegerObject:
+			timeout = time.Duration(seconds.value) * time.Second
+		case *FloatObject:
+			timeout = time.Duration(seconds.value * float64(time.Second))
+		}
 	}
 
-	gobyResp.InstanceVariableSet("@headers", t.vm.InitHashObject(underHeaders))
+	return t.vm.httpClient(timeout)
+}
 
-	return gobyResp, nil
+// sendRequest builds a request from the URL, the body and the optional headers hash, then sends it; common to `get`, `post` and `head`
+func sendRequest(t *Thread, gobyClient Object, method string, urlObj Object, contentType string, body io.Reader, args []Object, sourceLine int) Object {
+	u, ok := urlObj.(*StringObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, urlObj.Class().Name)
+	}
+
+	goReq, err := http.NewRequest(method, u.value, body)
+	if err != nil {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, err.Error())
+	}
+
+	if contentType != "" {
+		goReq.Header.Set("Content-Type", contentType)
+	}
+
+	if len(args) > 0 {
+		h, ok := args[0].(*HashObject)
+		if !ok {
+			return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.HashClass, args[0].Class().Name)
+		}
+
+		setHeaders(goReq.Header, h)
+	}
+
+	return doRequest(t, gobyClient, goReq, sourceLine)
+}
+
+// doRequest sends the request with the client, and returns a `Net::HTTP::Response` object or an HTTPError
+func doRequest(t *Thread, gobyClient Object, goReq *http.Request, sourceLine int) Object {
+	goClient := clientGobyToGo(t, gobyClient)
+
+	goResp, err := goClient.Do(goReq)
+	if err != nil {
+		if e, ok := err.(net.Error); ok && e.Timeout() {
+			return t.vm.InitErrorObject(errors.HTTPError, sourceLine, requestTimedOut, goClient.Timeout)
+		}
+
+		return t.vm.InitErrorObject(errors.HTTPError, sourceLine, couldNotCompleteRequest, err)
+	}
+
+	gobyResp, err := responseGoToGoby(t, goResp)
+	if err != nil {
+		return t.vm.InitErrorObject(errors.InternalError, sourceLine, err.Error())
+	}
+
+	return gobyResp
+}
+
+// setHeaders sets the pairs of the hash to the request headers
+func setHeaders(header http.Header, headers *HashObject) {
+	for k, v := range headers.Pairs {
+		if s, ok := v.(*StringObject); ok {
+			header.Set(k, s.value)
+		} else {
+			header.Set(k, v.ToString())
+		}
+	}
 }
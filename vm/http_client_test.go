@@ -1,6 +1,14 @@
 package vm
 
-import "testing"
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestHTTPClientObject(t *testing.T) {
 
@@ -100,3 +108,240 @@ func TestHTTPClientObjectFail(t *testing.T) {
 		v.checkSP(t, i, 2)
 	}
 }
+
+func TestHTTPClientHeadersAndTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			panic(err)
+		}
+
+		w.Header().Set("X-Served-By", "goby-test")
+		fmt.Fprintf(w, "%s %s %s %s", r.Method, r.Header.Get("Content-Type"), r.Header.Get("X-Token"), b)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		require "net/http"
+
+		headers = {}
+		headers["X-Token"] = "secret"
+
+		res = Net::HTTP.start do |client|
+			client.get("SERVER_URL/index", headers)
+		end
+
+		res.body
+		`, "GET  secret "},
+		{`
+		require "net/http"
+
+		headers = {}
+		headers["X-Token"] = "secret"
+
+		res = Net::HTTP.start do |client|
+			client.post("SERVER_URL/index", "application/json", "{}", headers)
+		end
+
+		res.body
+		`, "POST application/json secret {}"},
+		{`
+		require "net/http"
+
+		res = Net::HTTP.start do |client|
+			r = client.request
+			r.url = "SERVER_URL/index"
+			r.method = "PUT"
+			r.body = "body"
+			r.set_header("X-Token", "secret")
+			client.exec(r)
+		end
+
+		res.body
+		`, "PUT  secret body"},
+		{`
+		require "net/http"
+
+		headers = {}
+		headers["X-Token"] = "secret"
+
+		res = Net::HTTP.start do |client|
+			client.head("SERVER_URL/index", headers)
+		end
+
+		res.headers["X-Served-By"]
+		`, []interface{}{"goby-test"}},
+		{`
+		require "net/http"
+
+		res = Net::HTTP.start do |client|
+			client.get("SERVER_URL/index")
+		end
+
+		res.status_code
+		`, 200},
+		{`
+		require "net/http"
+
+		Net::HTTP.start do |client|
+			client.timeout
+		end
+		`, nil},
+		{`
+		require "net/http"
+
+		Net::HTTP.start do |client|
+			client.timeout = 2
+			client.timeout
+		end
+		`, 2},
+		{`
+		require "net/http"
+
+		res = Net::HTTP.start do |client|
+			client.timeout = 1.5
+			client.get("SERVER_URL/slow")
+		end
+
+		res.body
+		`, "GET   "},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, strings.Replace(tt.input, "SERVER_URL", server.URL, -1), getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestHTTPClientHeadersAndTimeoutFail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	testsFail := []errorTestCase{
+		{`
+		require "net/http"
+
+		Net::HTTP.start do |client|
+			client.timeout = 0.05
+			client.get("SERVER_URL/slow")
+		end
+		`, "HTTPError: Request timed out after 50ms", 4},
+		{`
+		require "net/http"
+
+		Net::HTTP.start do |client|
+			client.get("SERVER_URL", "X-Token")
+		end
+		`, "TypeError: Expect argument to be Hash. got: String", 4},
+		{`
+		require "net/http"
+
+		Net::HTTP.start do |client|
+			client.get
+		end
+		`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 4},
+		{`
+		require "net/http"
+
+		Net::HTTP.start do |client|
+			client.post("SERVER_URL", "text/plain")
+		end
+		`, "ArgumentError: Expect 3 to 4 argument(s). got: 2", 4},
+		{`
+		require "net/http"
+
+		Net::HTTP.start do |client|
+			client.post("SERVER_URL", "text/plain", 1)
+		end
+		`, "TypeError: Expect argument to be String. got: Integer", 4},
+		{`
+		require "net/http"
+
+		Net::HTTP.start do |client|
+			client.timeout = "1"
+		end
+		`, "TypeError: Expect argument to be Numeric. got: String", 4},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, strings.Replace(tt.input, "SERVER_URL", server.URL, -1), getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 2)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestHTTPClientWithTransport(t *testing.T) {
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Header:     http.Header{"X-Path": []string{r.URL.Path}, "Set-Cookie": []string{"a=1", "b=2"}},
+			Body:       ioutil.NopCloser(strings.NewReader(r.Method + " " + r.URL.Host)),
+			Request:    r,
+		}, nil
+	})
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		require "net/http"
+
+		Net::HTTP.get("http://goby.test/index")
+		`, "GET goby.test"},
+		{`
+		require "net/http"
+
+		Net::HTTP.post("http://goby.test/index", "text/plain", "body")
+		`, "POST goby.test"},
+		{`
+		require "net/http"
+
+		res = Net::HTTP.start do |client|
+			client.get("http://goby.test/index")
+		end
+
+		res.headers["X-Path"]
+		`, []interface{}{"/index"}},
+		{`
+		require "net/http"
+
+		res = Net::HTTP.start do |client|
+			client.get("http://goby.test/index")
+		end
+
+		res.headers["Set-Cookie"]
+		`, []interface{}{"a=1", "b=2"}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetHTTPTransport(transport)
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
@@ -28,11 +28,34 @@ var operations [bytecode.InstructionCount]operation
 func init() {
 	operations = [bytecode.InstructionCount]operation{
 		bytecode.Pop: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
+			// Pops the top object, or keeps it and pops the n objects under it if n is given
+			if len(args) > 0 {
+				top := t.Stack.Pop()
+
+				for i := 0; i < args[0].(int); i++ {
+					t.Stack.Pop()
+				}
+
+				t.Stack.Push(top)
+				return
+			}
+
 			t.Stack.Pop()
 		},
 		bytecode.Dup: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
-			obj := t.Stack.top().Target
-			t.Stack.Push(&Pointer{Target: obj})
+			// Duplicates the top n objects, 1 by default
+			n := 1
+
+			if len(args) > 0 {
+				n = args[0].(int)
+			}
+
+			start := t.Stack.pointer - n
+
+			for i := 0; i < n; i++ {
+				obj := t.Stack.data[start+i].Target
+				t.Stack.Push(&Pointer{Target: obj})
+			}
 		},
 		bytecode.PutBoolean: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
 			object := t.vm.InitObjectFromGoType(args[0])
@@ -44,7 +67,7 @@ func init() {
 		},
 		bytecode.GetConstant: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
 			constName := args[0].(string)
-			c := t.vm.lookupConstant(cf, constName)
+			c := t.vm.lookupConstant(t, cf, constName)
 
 			if c == nil {
 				t.pushErrorObject(errors.NameError, sourceLine, "uninitialized constant %s", constName)
@@ -85,6 +108,11 @@ func init() {
 		bytecode.SetInstanceVariable: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
 			variableName := args[0].(string)
 			p := t.Stack.Pop()
+
+			if cf.self.isFrozen() {
+				t.pushErrorObject(errors.FrozenError, sourceLine, errors.CantModifyFrozen, cf.self.Class().Name, cf.self.Inspect())
+			}
+
 			cf.self.InstanceVariableSet(variableName, p.Target)
 
 			var obj Object
@@ -153,7 +181,9 @@ func init() {
 			rangeEnd := t.Stack.Pop().Target.(*IntegerObject).value
 			rangeStart := t.Stack.Pop().Target.(*IntegerObject).value
 
-			t.Stack.Push(&Pointer{Target: t.vm.initRangeObject(rangeStart, rangeEnd)})
+			exclusive, _ := args[0].(bool)
+
+			t.Stack.Push(&Pointer{Target: t.vm.initRangeObject(rangeStart, rangeEnd, exclusive)})
 
 		},
 		bytecode.NewArray: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
@@ -208,15 +238,18 @@ func init() {
 		},
 		bytecode.NewHash: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
 			argCount := args[0].(int)
-			pairs := map[string]Object{}
+			pairs := make([]*Pointer, argCount)
 
-			for i := 0; i < argCount/2; i++ {
-				v := t.Stack.Pop()
-				k := t.Stack.Pop()
-				pairs[k.Target.(*StringObject).value] = v.Target
+			// Pairs are pushed in the literal's order, so fill them from the back to keep that order
+			for i := argCount - 1; i >= 0; i-- {
+				pairs[i] = t.Stack.Pop()
+			}
+
+			hash := t.vm.InitHashObject(map[string]Object{})
+			for i := 0; i < argCount; i += 2 {
+				hash.set(pairs[i].Target.(*StringObject).value, pairs[i+1].Target)
 			}
 
-			hash := t.vm.InitHashObject(pairs)
 			t.Stack.Push(&Pointer{Target: hash})
 
 		},
@@ -275,6 +308,9 @@ func init() {
 			*/
 
 			if cf.IsBlock() {
+				// The method call that yields the block evaluates to the break value
+				cf.blockFrame.breakValue = t.Stack.Pop().Target
+
 				/*
 				  1. Remove block execution frame
 				  2. Remove method call frame
@@ -294,6 +330,12 @@ func init() {
 		},
 		bytecode.PutString: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
 			object := t.vm.InitObjectFromGoType(args[0])
+
+			// Symbols are frozen strings
+			if len(args) > 1 && args[1].(bool) {
+				object.freeze()
+			}
+
 			t.Stack.Push(&Pointer{Target: object})
 
 		},
@@ -364,7 +406,7 @@ func init() {
 
 				if len(args) >= 2 {
 					superClassName := args[1].(string)
-					superClass := t.vm.lookupConstant(cf, superClassName)
+					superClass := t.vm.lookupConstant(t, cf, superClassName)
 					inheritedClass, ok := superClass.Target.(*RClass)
 
 					if !ok {
@@ -404,17 +446,17 @@ func init() {
 
 			argSet := args[3].(*bytecode.ArgSet)
 
-			// Deal with splat arguments
-			if arr, ok := t.Stack.top().Target.(*ArrayObject); ok && arr.splat {
-				// Pop array
-				t.Stack.Pop()
-				// Can't count array itself, only the number of array elements
-				argCount = argCount - 1 + len(arr.Elements)
-				for _, elem := range arr.Elements {
-					t.Stack.Push(&Pointer{Target: elem})
-				}
+			// The block given by `foo(&block)` is on top of the arguments
+			var blockArg Object
+
+			if blockFlag == bytecode.BlockArgFlag {
+				blockArg = t.Stack.Pop().Target
+				blockFlag = ""
 			}
 
+			// Deal with splat arguments
+			argCount, argSet = t.expandSplatArguments(argCount, argSet)
+
 			argPr := t.Stack.pointer - argCount
 			receiverPr := argPr - 1
 			receiver := t.Stack.data[receiverPr].Target
@@ -457,6 +499,20 @@ func init() {
 				t.callFrameStack.push(blockFrame)
 			}
 
+			switch b := blockArg.(type) {
+			case nil, *NullObject:
+			case *BlockObject:
+				// Unlike block literals, the block object keeps its own environment
+				blockFrame = newNormalCallFrame(b.instructionSet, b.instructionSet.filename, sourceLine)
+				blockFrame.ep = b.ep
+				blockFrame.self = b.self
+				blockFrame.isSourceBlock = true
+				blockFrame.isBlock = true
+				t.callFrameStack.push(blockFrame)
+			default:
+				t.setErrorObject(receiverPr, argPr, errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.BlockClass, b.Class().Name)
+			}
+
 			switch m := method.(type) {
 			case *MethodObject:
 				callObj := newCallObject(receiver, m, receiverPr, argCount, argSet, blockFrame, sourceLine)
@@ -467,6 +523,10 @@ func init() {
 				t.pushErrorObject(errors.InternalError, sourceLine, m.ToString())
 			}
 
+			if blockFrame != nil && blockFrame.IsRemoved() && blockFrame.breakValue != nil {
+				t.Stack.Set(receiverPr, &Pointer{Target: blockFrame.breakValue})
+			}
+
 		},
 		bytecode.InvokeBlock: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
 			argCount := args[0].(int)
@@ -525,6 +585,12 @@ func init() {
 		},
 		bytecode.GetBlock: func(t *Thread, sourceLine int, cf *normalCallFrame, args ...interface{}) {
 			if cf.blockFrame == nil {
+				// A `&block` parameter is nil when no block is given
+				if len(args) > 0 && args[0].(bool) {
+					t.Stack.Push(&Pointer{Target: NULL})
+					return
+				}
+
 				t.pushErrorObject(errors.InternalError, sourceLine, "Can't get block without a block argument")
 			}
 
@@ -534,7 +600,7 @@ func init() {
 				blockFrame = cf.blockFrame.ep.blockFrame
 			}
 
-			blockObject := t.vm.initBlockObject(blockFrame.instructionSet, blockFrame.ep, t.Stack.data[t.Stack.pointer-1].Target)
+			blockObject := t.vm.initBlockObject(blockFrame.instructionSet, blockFrame.ep, blockFrame.self)
 
 			t.Stack.Push(&Pointer{Target: blockObject})
 
@@ -2,6 +2,8 @@ package vm
 
 import (
 	"math"
+	"math/big"
+	"math/bits"
 	"strconv"
 
 	"github.com/goby-lang/goby/vm/classes"
@@ -135,20 +137,36 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns self squaring another Numeric.
+		// Returns self squaring another Numeric. A negative Integer exponent returns a Float.
+		// Raises a RangeError if the result is too large for an Integer.
 		//
 		// ```Ruby
-		// 2 ** 8 # => 256
+		// 2 ** 8  # => 256
+		// 2 ** -2 # => 0.25
 		// ```
 		// @return [Numeric]
 		Name: "**",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			intOperation := func(leftValue int, rightValue int) int {
-				return int(math.Pow(float64(leftValue), float64(rightValue)))
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
 			}
-			floatOperation := math.Pow
 
-			return receiver.(*IntegerObject).arithmeticOperation(t, args[0], intOperation, floatOperation, sourceLine, false)
+			i := receiver.(*IntegerObject)
+			if exp, ok := args[0].(*IntegerObject); ok {
+				if exp.value < 0 {
+					return t.vm.initFloatObject(math.Pow(float64(i.value), float64(exp.value)))
+				}
+
+				result, ok := intPow(i.value, exp.value)
+				if !ok {
+					return t.vm.InitErrorObject(errors.RangeError, sourceLine, errors.IntegerOverflow, i.value, exp.value)
+				}
+
+				return t.vm.InitIntegerObject(result)
+			}
+
+			// Integer exponents are handled above, so only the Float operation is needed
+			return i.arithmeticOperation(t, args[0], nil, math.Pow, sourceLine, false)
 
 		},
 	},
@@ -278,11 +296,13 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// Returns 1 if self is larger than the incoming Numeric, -1 if smaller. Otherwise 0.
+		// Returns nil if the incoming object is not a Numeric.
 		//
 		// ```Ruby
-		// 1 <=> 3 # => -1
-		// 1 <=> 1 # => 0
-		// 3 <=> 1 # => 1
+		// 1 <=> 3   # => -1
+		// 1 <=> 1   # => 0
+		// 3 <=> 1   # => 1
+		// 1 <=> "a" # => nil
 		// ```
 		// @return [Integer]
 		Name: "<=>",
@@ -315,7 +335,7 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 
 				return t.vm.InitIntegerObject(0)
 			default:
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", rightObject.Class().Name)
+				return NULL
 			}
 
 		},
@@ -358,6 +378,62 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns the absolute value of self.
+		//
+		// ```ruby
+		// -7.abs # => 7
+		// 7.abs  # => 7
+		// ```
+		// @return [Integer]
+		Name: "abs",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			i := receiver.(*IntegerObject)
+			if i.value < 0 {
+				return t.vm.InitIntegerObject(-i.value)
+			}
+
+			return i
+
+		},
+	},
+	{
+		// Yields the integers from self down to the limit inclusive to the block in descending order, and returns self.
+		// Nothing is yielded if the limit is bigger than self.
+		// If no block is given, returns an array of the integers instead.
+		//
+		// ```ruby
+		// 3.downto(1) do |i|
+		//   puts(i)
+		// end
+		// # => 3
+		// # => 2
+		// # => 1
+		//
+		// 3.downto(1) # => [3, 2, 1]
+		// ```
+		// @param limit [Integer]
+		// @return [Integer]
+		Name: "downto",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			limit, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			i := receiver.(*IntegerObject)
+			return i.iterate(t, i.value, limit.value, -1, blockFrame)
+
+		},
+	},
 	{
 		// Returns if self is even.
 		//
@@ -383,6 +459,68 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns the greatest common divisor of self and the given integer, which is always positive or zero.
+		// `n.gcd(0)` returns the absolute value of `n`.
+		//
+		// ```ruby
+		// 12.gcd(18) # => 6
+		// -4.gcd(6)  # => 2
+		// 5.gcd(0)   # => 5
+		// ```
+		// @param other [Integer]
+		// @return [Integer]
+		Name: "gcd",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			other, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			i := receiver.(*IntegerObject)
+			return t.vm.InitIntegerObject(gcd(i.value, other.value))
+
+		},
+	},
+	{
+		// Returns the least common multiple of self and the given integer, which is always positive or zero.
+		//
+		// ```ruby
+		// 4.lcm(6)  # => 12
+		// -3.lcm(5) # => 15
+		// 5.lcm(0)  # => 0
+		// ```
+		// @param other [Integer]
+		// @return [Integer]
+		Name: "lcm",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			other, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			i := receiver.(*IntegerObject)
+			if i.value == 0 || other.value == 0 {
+				return t.vm.InitIntegerObject(0)
+			}
+
+			lcm := i.value / gcd(i.value, other.value) * other.value
+			if lcm < 0 {
+				lcm = -lcm
+			}
+
+			return t.vm.InitIntegerObject(lcm)
+
+		},
+	},
 	// Returns the `Decimal` conversion of self.
 	//
 	// ```Ruby
@@ -438,21 +576,40 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns a `String` representation of self.
+		// Returns a `String` representation of self in the given base (10 by default).
+		// The base should be between 2 and 36.
 		//
 		// ```Ruby
-		// 100.to_s # => "100"
+		// 100.to_s     # => "100"
+		// 255.to_s(16) # => "ff"
+		// 5.to_s(2)    # => "101"
+		// -5.to_s(2)   # => "-101"
 		// ```
+		// @param base [Integer]
 		// @return [String]
 		Name: "to_s",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 0 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			aLen := len(args)
+			if aLen > 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
+			}
+
+			base := 10
+			if aLen == 1 {
+				b, ok := args[0].(*IntegerObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+				}
+
+				if b.value < 2 || b.value > 36 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidRadix, b.value)
+				}
+				base = b.value
 			}
 
 			int := receiver.(*IntegerObject)
 
-			return t.vm.InitStringObject(strconv.Itoa(int.value))
+			return t.vm.InitStringObject(strconv.FormatInt(int64(int.value), base))
 
 		},
 	},
@@ -498,6 +655,72 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns self raised to the power of the given Integer. A negative exponent returns a Float.
+		// Raises a RangeError if the result is too large for an Integer.
+		// When a modulus is given, returns `(self ** exp) % mod` computed without the intermediate power,
+		// which keeps large exponents cheap.
+		//
+		// ```ruby
+		// 2.pow(10)       # => 1024
+		// 2.pow(-2)       # => 0.25
+		// 3.pow(200, 7)   # => 2
+		// ```
+		// @param exp [Integer], mod [Integer]
+		// @return [Numeric]
+		Name: "pow",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			aLen := len(args)
+			if aLen < 1 || aLen > 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
+			}
+
+			i := receiver.(*IntegerObject)
+			exp, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			if aLen == 1 {
+				if exp.value < 0 {
+					return t.vm.initFloatObject(math.Pow(float64(i.value), float64(exp.value)))
+				}
+
+				result, ok := intPow(i.value, exp.value)
+				if !ok {
+					return t.vm.InitErrorObject(errors.RangeError, sourceLine, errors.IntegerOverflow, i.value, exp.value)
+				}
+
+				return t.vm.InitIntegerObject(result)
+			}
+
+			mod, ok := args[1].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, classes.IntegerClass, args[1].Class().Name)
+			}
+
+			if exp.value < 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.NegativeValue, exp.value)
+			}
+
+			if mod.value == 0 {
+				return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+			}
+
+			// big.Int's Exp works with |mod|, so the result's sign follows the modulus like `%` does
+			m := big.NewInt(int64(mod.value))
+			result := new(big.Int).Exp(big.NewInt(int64(i.value)), big.NewInt(int64(exp.value)), new(big.Int).Abs(m))
+			if result.Sign() < 0 {
+				result.Add(result, new(big.Int).Abs(m))
+			}
+			if mod.value < 0 && result.Sign() > 0 {
+				result.Add(result, m)
+			}
+
+			return t.vm.InitIntegerObject(int(result.Int64()))
+
+		},
+	},
 	{
 		// Returns self - 1.
 		//
@@ -517,7 +740,50 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Yields a block a number of times equals to self.
+		// Yields the integers from self toward the limit inclusive by the given stride to the block, and returns self.
+		// A negative stride counts downward. Nothing is yielded if the limit is on the other side of self.
+		// If no block is given, returns an array of the integers instead.
+		//
+		// ```ruby
+		// 0.step(10, 4) do |i|
+		//   puts(i)
+		// end
+		// # => 0
+		// # => 4
+		// # => 8
+		//
+		// 0.step(10, 2)  # => [0, 2, 4, 6, 8, 10]
+		// 10.step(1, -3) # => [10, 7, 4, 1]
+		// ```
+		// @param limit [Integer], stride [Integer]
+		// @return [Integer]
+		Name: "step",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 2, len(args))
+			}
+
+			for n, arg := range args {
+				if _, ok := arg.(*IntegerObject); !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, n+1, classes.IntegerClass, arg.Class().Name)
+				}
+			}
+
+			limit := args[0].(*IntegerObject)
+			stride := args[1].(*IntegerObject)
+			if stride.value == 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.ZeroStep)
+			}
+
+			i := receiver.(*IntegerObject)
+			return i.iterate(t, i.value, limit.value, stride.value, blockFrame)
+
+		},
+	},
+	{
+		// Yields the integers from 0 to self - 1 to the block in order, and returns self.
+		// If no block is given, returns an array of the integers instead.
+		// Nothing is yielded if self is zero or negative.
 		//
 		// ```Ruby
 		// a = 0
@@ -525,32 +791,53 @@ var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
 		//    a += 1
 		// end
 		// a # => 3
-		// ```
-		// This is temporarily replaced by lib/integer.gb
-		// TODO: Write the same implementation in Go
-		//Name: "times",
-		//Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-		//	if len(args) != 0 {
-		//		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
-		//	}
-		//
-		//	n := receiver.(*IntegerObject)
-		//
-		//	if n.value < 0 {
-		//		return t.vm.InitErrorObject(errors.InternalError, sourceLine, "Expect the receiver to be positive integer. got: %d", n.value)
-		//	}
 		//
-		//	if blockFrame == nil {
-		//		return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
-		//	}
-		//
-		//	for i := 0; i < n.value; i++ {
-		//		t.builtinMethodYield(blockFrame, t.vm.InitIntegerObject(i))
-		//	}
+		// 3.times # => [0, 1, 2]
+		// ```
+		// @return [Integer]
+		Name: "times",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			n := receiver.(*IntegerObject)
+			return n.iterate(t, 0, n.value-1, 1, blockFrame)
+
+		},
+	},
+	{
+		// Yields the integers from self up to the limit inclusive to the block in ascending order, and returns self.
+		// Nothing is yielded if the limit is smaller than self.
+		// If no block is given, returns an array of the integers instead.
 		//
-		//	return n
+		// ```ruby
+		// 1.upto(3) do |i|
+		//   puts(i)
+		// end
+		// # => 1
+		// # => 2
+		// # => 3
 		//
-		//},
+		// 1.upto(3) # => [1, 2, 3]
+		// ```
+		// @param limit [Integer]
+		// @return [Integer]
+		Name: "upto",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			limit, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			i := receiver.(*IntegerObject)
+			return i.iterate(t, i.value, limit.value, 1, blockFrame)
+
+		},
 	},
 	{
 		Name: "to_int",
@@ -750,7 +1037,6 @@ func (vm *VM) initIntegerClass() *RClass {
 	ic := vm.initializeClass(classes.IntegerClass)
 	ic.setBuiltinMethods(builtinIntegerInstanceMethods, false)
 	ic.setBuiltinMethods(builtinIntegerClassMethods, true)
-	vm.libFiles = append(vm.libFiles, "integer.gb")
 	return ic
 }
 
@@ -872,6 +1158,91 @@ func (i *IntegerObject) equal(e *IntegerObject) bool {
 	return i.value == e.value
 }
 
+// iterate yields the integers from `from` to `to` inclusive with the given stride to the block, and returns the receiver.
+// If no block is given, returns an array of the integers instead; common to `downto`, `step`, `times` and `upto`.
+func (i *IntegerObject) iterate(t *Thread, from, to, stride int, blockFrame *normalCallFrame) Object {
+	inRange := func(n int) bool {
+		if stride > 0 {
+			return n <= to
+		}
+		return n >= to
+	}
+
+	if blockFrame == nil {
+		elements := []Object{}
+		for n := from; inRange(n); n += stride {
+			elements = append(elements, t.vm.InitIntegerObject(n))
+		}
+
+		return t.vm.InitArrayObject(elements)
+	}
+
+	if blockIsEmpty(blockFrame) {
+		return i
+	}
+
+	// If there's nothing to yield, pop the block's call frame
+	if !inRange(from) {
+		t.callFrameStack.pop()
+	}
+
+	for n := from; inRange(n); n += stride {
+		t.builtinMethodYield(blockFrame, t.vm.InitIntegerObject(n))
+	}
+
+	return i
+}
+
+// intPow returns base raised to the non-negative exp by squaring, so the result stays exact.
+// ok is false if the result overflows Integer.
+func intPow(base, exp int) (result int, ok bool) {
+	result = 1
+	for exp > 0 {
+		if exp&1 == 1 {
+			if result, ok = mulInt(result, base); !ok {
+				return 0, false
+			}
+		}
+
+		exp >>= 1
+		if exp > 0 {
+			if base, ok = mulInt(base, base); !ok {
+				return 0, false
+			}
+		}
+	}
+
+	return result, true
+}
+
+// mulInt returns a * b, and ok is false if the product overflows Integer
+func mulInt(a, b int) (product int, ok bool) {
+	const minInt = -1 << (bits.UintSize - 1)
+
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+
+	if (a == -1 && b == minInt) || (b == -1 && a == minInt) {
+		return 0, false
+	}
+
+	product = a * b
+	return product, product/b == a
+}
+
+// gcd returns the greatest common divisor of the integers with Euclid's algorithm
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
 func (i *IntegerObject) lessThan(arg Object) bool {
 	intComparison := func(leftValue int, rightValue int) bool {
 		return leftValue < rightValue
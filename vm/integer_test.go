@@ -33,6 +33,11 @@ func TestIntegerArithmeticOperationWithInteger(t *testing.T) {
 		{`13  %  3`, 1},
 		{`13  /  3`, 4},
 		{`13  ** 3`, 2197},
+		{`2   ** 62`, 4611686018427387904},
+		{`-2  ** 63`, -9223372036854775808},
+		{`1   ** 1000000`, 1},
+		{`-1  ** 1000001`, -1},
+		{`2   ** -2`, 0.25},
 	}
 
 	for i, tt := range tests {
@@ -93,6 +98,9 @@ func TestIntegerArithmeticOperationFail(t *testing.T) {
 		{`1 + "p"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 		{`1 - "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 		{`1 ** "p"`, "TypeError: Expect argument to be Numeric. got: String", 1},
+		{`2 ** 64`, "RangeError: Integer overflow: 2 ** 64", 1},
+		{`2 ** 63`, "RangeError: Integer overflow: 2 ** 63", 1},
+		{`10 ** 19`, "RangeError: Integer overflow: 10 ** 19", 1},
 		{`1 / "t"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 	}
 
@@ -125,6 +133,8 @@ func TestIntegerComparisonWithInteger(t *testing.T) {
 		{`1 <=> 2`, -1},
 		{`2 <=> 1`, 1},
 		{`3 <=> 3`, 0},
+		{`1 <=> "m"`, nil},
+		{`1 <=> nil`, nil},
 	}
 
 	for i, tt := range tests {
@@ -173,7 +183,6 @@ func TestIntegerComparisonFail(t *testing.T) {
 		{`1 >= "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 		{`1 < "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 		{`1 <= "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
-		{`1 <=> "m"`, "TypeError: Expect argument to be Numeric. got: String", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -227,6 +236,20 @@ func TestIntegerConversion(t *testing.T) {
 		{`100.to_f`, 100.0},
 		{`-100.to_f`, -100.0},
 		{`100.to_s`, "100"},
+		{`255.to_s(16)`, "ff"},
+		{`5.to_s(2)`, "101"},
+		{`8.to_s(8)`, "10"},
+		{`35.to_s(36)`, "z"},
+		{`100.to_s(10)`, "100"},
+		{`0.to_s(2)`, "0"},
+		{`
+		a = -255
+		a.to_s(16)
+		`, "-ff"},
+		{`
+		a = -5
+		a.to_s(2)
+		`, "-101"},
 		{`100.to_d.to_i`, 100},
 		{`-100.to_d.to_i`, -100},
 		{`100.to_d.numerator.to_i`, 100},
@@ -245,8 +268,121 @@ func TestIntegerConversion(t *testing.T) {
 	}
 }
 
+func TestIntegerConversionFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`100.to_s(1)`, "ArgumentError: Invalid radix. got: 1", 1},
+		{`100.to_s(37)`, "ArgumentError: Invalid radix. got: 37", 1},
+		{`100.to_s(2, 8)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`100.to_s("2")`, "TypeError: Expect argument to be Integer. got: String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 // Method test
 
+func TestIntegerAbsMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`7.abs`, 7},
+		{`0.abs`, 0},
+		{`
+		a = -7
+		a.abs
+		`, 7},
+		{`(-7).abs`, 7},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerAbsMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`7.abs(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerDowntoMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		a = []
+		3.downto(1) do |i|
+		  a.push(i)
+		end
+		a.to_s
+		`, "[3, 2, 1]"},
+		{`
+		3.downto(1) do |i|
+		  i
+		end
+		`, 3},
+		{`3.downto(1).to_s`, "[3, 2, 1]"},
+		{`3.downto(3).to_s`, "[3]"},
+		{`
+		a = []
+		r = 1.downto(3) do |i|
+		  a.push(i)
+		end
+		a.to_s + r.to_s
+		`, "[]1"},
+		{`1.downto(3).to_s`, "[]"},
+		{`
+		3.downto(1) do
+		end
+		`, 3},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerDowntoMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`1.downto`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`1.downto(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`1.downto("3")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`1.downto(3.0)`, "TypeError: Expect argument to be Integer. got: Float", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestIntegerEvenMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -254,6 +390,34 @@ func TestIntegerEvenMethod(t *testing.T) {
 	}{
 		{`1.even?`, false},
 		{`2.even?`, true},
+		{`6.even?`, true},
+		{`0.even?`, true},
+		{`-3.even?`, false},
+		{`-4.even?`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerGcdMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`12.gcd(18)`, 6},
+		{`18.gcd(12)`, 6},
+		{`7.gcd(13)`, 1},
+		{`-4.gcd(6)`, 2},
+		{`4.gcd(-6)`, 2},
+		{`5.gcd(0)`, 5},
+		{`-5.gcd(0)`, 5},
+		{`0.gcd(0)`, 0},
 	}
 
 	for i, tt := range tests {
@@ -265,6 +429,63 @@ func TestIntegerEvenMethod(t *testing.T) {
 	}
 }
 
+func TestIntegerGcdMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`12.gcd`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`12.gcd(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`12.gcd("18")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`12.gcd(1.5)`, "TypeError: Expect argument to be Integer. got: Float", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerLcmMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`4.lcm(6)`, 12},
+		{`6.lcm(4)`, 12},
+		{`7.lcm(13)`, 91},
+		{`-3.lcm(5)`, 15},
+		{`3.lcm(-5)`, 15},
+		{`5.lcm(0)`, 0},
+		{`0.lcm(0)`, 0},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerLcmMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`12.lcm`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`12.lcm(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`12.lcm("18")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`12.lcm(1.5)`, "TypeError: Expect argument to be Integer. got: Float", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestIntegerNextMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -290,6 +511,8 @@ func TestIntegerOddMethod(t *testing.T) {
 	}{
 		{`1.odd?`, true},
 		{`2.odd?`, false},
+		{`-3.odd?`, true},
+		{`-4.odd?`, false},
 	}
 
 	for i, tt := range tests {
@@ -301,6 +524,54 @@ func TestIntegerOddMethod(t *testing.T) {
 	}
 }
 
+func TestIntegerPowMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`2.pow(10)`, 1024},
+		{`2.pow(0)`, 1},
+		{`0.pow(0)`, 1},
+		{`-3.pow(3)`, -27},
+		{`3.pow(39)`, 4052555153018976267},
+		{`2.pow(-2)`, 0.25},
+		{`3.pow(200, 7)`, 2},
+		{`2.pow(10, 1000)`, 24},
+		{`-2.pow(3, 5)`, 2},
+		{`2.pow(3, -5)`, -2},
+		{`123456789.pow(987654321, 1000000007)`, 652541198},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerPowMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`2.pow`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`2.pow(1, 2, 3)`, "ArgumentError: Expect 1 to 2 argument(s). got: 3", 1},
+		{`2.pow(1.5)`, "TypeError: Expect argument #1 to be Integer. got: Float", 1},
+		{`2.pow("2")`, "TypeError: Expect argument #1 to be Integer. got: String", 1},
+		{`2.pow(2, "3")`, "TypeError: Expect argument #2 to be Integer. got: String", 1},
+		{`2.pow(-2, 3)`, "ArgumentError: Expect argument to be positive value. got: -2", 1},
+		{`2.pow(2, 0)`, "ZeroDivisionError: Divided by 0", 1},
+		{`3.pow(41)`, "RangeError: Integer overflow: 3 ** 41", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestIntegerPredMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -319,6 +590,70 @@ func TestIntegerPredMethod(t *testing.T) {
 	}
 }
 
+func TestIntegerStepMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		a = []
+		0.step(10, 2) do |i|
+		  a.push(i)
+		end
+		a.to_s
+		`, "[0, 2, 4, 6, 8, 10]"},
+		{`
+		0.step(10, 2) do |i|
+		  i
+		end
+		`, 0},
+		{`0.step(10, 3).to_s`, "[0, 3, 6, 9]"},
+		{`0.step(10, 20).to_s`, "[0]"},
+		{`10.step(1, -3).to_s`, "[10, 7, 4, 1]"},
+		{`10.step(0, -4).to_s`, "[10, 6, 2]"},
+		{`1.step(1, 5).to_s`, "[1]"},
+		{`0.step(-5, 1).to_s`, "[]"},
+		{`0.step(5, -1).to_s`, "[]"},
+		{`
+		a = []
+		r = 0.step(-5, 1) do |i|
+		  a.push(i)
+		end
+		a.to_s + r.to_s
+		`, "[]0"},
+		{`
+		5.step(10, 1) do
+		end
+		`, 5},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerStepMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`1.step(10)`, "ArgumentError: Expect 2 argument(s). got: 1", 1},
+		{`1.step(10, 1, 2)`, "ArgumentError: Expect 2 argument(s). got: 3", 1},
+		{`1.step("10", 1)`, "TypeError: Expect argument #1 to be Integer. got: String", 1},
+		{`1.step(10, 0.5)`, "TypeError: Expect argument #2 to be Integer. got: Float", 1},
+		{`1.step(10, 0)`, "ArgumentError: Step can't be 0", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestIntegerTimesMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -330,6 +665,45 @@ func TestIntegerTimesMethod(t *testing.T) {
 			end
 			a
 			`, 3},
+		{`
+		sum = 0
+		3.times do |i|
+		  sum = sum + i
+		end
+		sum
+		`, 3},
+		{`
+		a = []
+		4.times do |i|
+		  a.push(i)
+		end
+		a.to_s
+		`, "[0, 1, 2, 3]"},
+		{`
+		3.times do |i|
+		  i
+		end
+		`, 3},
+		{`
+		a = []
+		0.times do |i|
+		  a.push(i)
+		end
+		a.to_s
+		`, "[]"},
+		{`
+		a = []
+		r = -2.times do |i|
+		  a.push(i)
+		end
+		a.to_s + r.to_s
+		`, "[]-2"},
+		{`
+		3.times do
+		end
+		`, 3},
+		{`3.times.to_s`, "[0, 1, 2]"},
+		{`0.times.to_s`, "[]"},
 	}
 
 	for i, tt := range tests {
@@ -341,6 +715,80 @@ func TestIntegerTimesMethod(t *testing.T) {
 	}
 }
 
+func TestIntegerTimesMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`3.times(1) do
+		end`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerUptoMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		a = []
+		1.upto(3) do |i|
+		  a.push(i)
+		end
+		a.to_s
+		`, "[1, 2, 3]"},
+		{`
+		1.upto(3) do |i|
+		  i
+		end
+		`, 1},
+		{`1.upto(3).to_s`, "[1, 2, 3]"},
+		{`1.upto(1).to_s`, "[1]"},
+		{`
+		a = []
+		r = 3.upto(1) do |i|
+		  a.push(i)
+		end
+		a.to_s + r.to_s
+		`, "[]3"},
+		{`3.upto(1).to_s`, "[]"},
+		{`
+		1.upto(3) do
+		end
+		`, 1},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestIntegerUptoMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`1.upto`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`1.upto(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`1.upto("3")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`1.upto(3.0)`, "TypeError: Expect argument to be Integer. got: Float", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestIntegerZeroDivisionFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`6 / 0`, "ZeroDivisionError: Divided by 0", 1},
@@ -0,0 +1,245 @@
+package vm
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// MutexObject is a mutual exclusion lock, which is implemented with Go's `sync.Mutex`.
+// Use `synchronize` to guard objects shared between threads, such as arrays, hashes or local variables.
+//
+// ```ruby
+// m = Mutex.new
+// a = []
+// t = thread do
+//   m.synchronize do
+//     a.push(1)
+//   end
+// end
+// m.synchronize do
+//   a.push(2)
+// end
+// t.join
+// a.length #=> 2
+// ```
+//
+// Note that the lock is not reentrant: calling `lock` twice in the same thread blocks forever.
+type MutexObject struct {
+	*BaseObj
+	mutex sync.Mutex
+	// locked is 1 while the mutex is locked, and is accessed atomically
+	locked int32
+}
+
+// Class methods --------------------------------------------------------
+var builtinMutexClassMethods = []*BuiltinMethodObject{
+	{
+		// Creates an unlocked mutex.
+		//
+		// ```ruby
+		// m = Mutex.new
+		// m.locked? #=> false
+		// ```
+		//
+		// @return [Mutex]
+		Name: "new",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			return t.vm.initMutexObject()
+
+		},
+	},
+}
+
+// Instance methods -----------------------------------------------------
+var builtinMutexInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Acquires the lock and returns self.
+		// If the lock is held by other thread, waits until it's released.
+		//
+		// ```ruby
+		// m = Mutex.new
+		// m.lock
+		// # critical section
+		// m.unlock
+		// ```
+		//
+		// @return [Mutex]
+		Name: "lock",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			m := receiver.(*MutexObject)
+			m.lock()
+
+			return m
+
+		},
+	},
+	{
+		// Returns true if the lock is held by any thread.
+		//
+		// ```ruby
+		// m = Mutex.new
+		// m.lock
+		// m.locked? #=> true
+		// m.unlock
+		// m.locked? #=> false
+		// ```
+		//
+		// @return [Boolean]
+		Name: "locked?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			return toBooleanObject(atomic.LoadInt32(&receiver.(*MutexObject).locked) == 1)
+
+		},
+	},
+	{
+		// Runs the block with the lock held, and returns the result of the block.
+		// The lock is released when the block finishes, even if the block raises an error.
+		//
+		// ```ruby
+		// m = Mutex.new
+		// count = 0
+		//
+		// t1 = thread do
+		//   1000.times do
+		//     m.synchronize do
+		//       count += 1
+		//     end
+		//   end
+		// end
+		// t2 = thread do
+		//   1000.times do
+		//     m.synchronize do
+		//       count += 1
+		//     end
+		//   end
+		// end
+		//
+		// t1.join
+		// t2.join
+		// count #=> 2000
+		// ```
+		//
+		// @param block literal
+		// @return [Object]
+		Name: "synchronize",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			m := receiver.(*MutexObject)
+			m.lock()
+			defer m.unlock()
+
+			if blockIsEmpty(blockFrame) {
+				t.callFrameStack.pop()
+				return NULL
+			}
+
+			return t.builtinMethodYield(blockFrame).Target
+
+		},
+	},
+	{
+		// Releases the lock and returns self.
+		// An error is raised if the mutex is not locked.
+		//
+		// ```ruby
+		// m = Mutex.new
+		// m.lock
+		// m.unlock
+		// m.unlock # RuntimeError
+		// ```
+		//
+		// @return [Mutex]
+		Name: "unlock",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			m := receiver.(*MutexObject)
+			if !m.unlock() {
+				return t.vm.InitErrorObject(errors.RuntimeError, sourceLine, errors.MutexNotLocked)
+			}
+
+			return m
+
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initMutexObject() *MutexObject {
+	return &MutexObject{
+		BaseObj: &BaseObj{class: vm.TopLevelClass(classes.MutexClass)},
+	}
+}
+
+func (vm *VM) initMutexClass() *RClass {
+	mc := vm.initializeClass(classes.MutexClass)
+	mc.setBuiltinMethods(builtinMutexClassMethods, true)
+	mc.setBuiltinMethods(builtinMutexInstanceMethods, false)
+	return mc
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the object
+func (m *MutexObject) Value() interface{} {
+	return &m.mutex
+}
+
+// ToString returns the object's name as the string format
+func (m *MutexObject) ToString() string {
+	return "#<Mutex>"
+}
+
+// Inspect delegates to ToString
+func (m *MutexObject) Inspect() string {
+	return m.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (m *MutexObject) ToJSON(t *Thread) string {
+	return "\"" + m.ToString() + "\""
+}
+
+// lock acquires the lock and marks the mutex as locked
+func (m *MutexObject) lock() {
+	m.mutex.Lock()
+	atomic.StoreInt32(&m.locked, 1)
+}
+
+// unlock releases the lock, and returns false if the mutex is not locked.
+// Unlocking an unlocked sync.Mutex is a fatal error that can't be recovered, so it's checked beforehand.
+func (m *MutexObject) unlock() bool {
+	if !atomic.CompareAndSwapInt32(&m.locked, 1, 0) {
+		return false
+	}
+
+	m.mutex.Unlock()
+	return true
+}
@@ -0,0 +1,200 @@
+package vm
+
+import (
+	"testing"
+)
+
+func TestMutexClass(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Mutex.class.name`, "Class"},
+		{`Mutex.superclass.name`, "Object"},
+		{`Mutex.new.class.name`, "Mutex"},
+		{`Mutex.new.to_s`, "#<Mutex>"},
+		{`Mutex.new.locked?`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestMutexLockAndUnlockMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		m = Mutex.new
+		m.lock
+		m.locked?
+		`, true},
+		{`
+		m = Mutex.new
+		m.lock
+		m.unlock
+		m.locked?
+		`, false},
+		{`
+		m = Mutex.new
+		m.lock.unlock.to_s
+		`, "#<Mutex>"},
+		{`
+		m = Mutex.new
+		c = Channel.new
+		m.lock
+		t = thread do
+		  c.deliver(:waiting)
+		  m.lock
+		  m.unlock
+		  :done
+		end
+		c.receive
+		m.unlock
+		t.join
+		`, "done"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestMutexSynchronizeMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		m = Mutex.new
+		m.synchronize do
+		  10
+		end
+		`, 10},
+		{`
+		m = Mutex.new
+		m.synchronize do
+		  m.locked?
+		end
+		`, true},
+		{`
+		m = Mutex.new
+		m.synchronize do
+		  1
+		end
+		m.locked?
+		`, false},
+		{`
+		m = Mutex.new
+		m.synchronize do
+		end
+		`, nil},
+		{`
+		m = Mutex.new
+		m.synchronize do
+		end
+		m.locked?
+		`, false},
+		{`
+		m = Mutex.new
+		count = 0
+
+		t1 = thread do
+		  1000.times do
+		    m.synchronize do
+		      count += 1
+		    end
+		  end
+		end
+		t2 = thread do
+		  1000.times do
+		    m.synchronize do
+		      count += 1
+		    end
+		  end
+		end
+
+		t1.join
+		t2.join
+		count
+		`, 2000},
+		{`
+		m = Mutex.new
+		a = []
+		h = {}
+
+		threads = []
+		4.times do |i|
+		  t = thread do
+		    100.times do |j|
+		      m.synchronize do
+		        a.push(j)
+		        h[(i * 100 + j).to_s] = j
+		      end
+		    end
+		  end
+		  threads.push(t)
+		end
+		threads.each do |t|
+		  t.join
+		end
+
+		[a.length, h.length]
+		`, []interface{}{400, 400}},
+		{`
+		m = Mutex.new
+		t = thread do
+		  m.synchronize do
+		    1 / 0
+		  end
+		end
+		while t.alive? do
+		end
+		m.locked?
+		`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestMutexMethodsFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Mutex.new(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`Mutex.new.lock(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`Mutex.new.locked?(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`Mutex.new.unlock(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`Mutex.new.unlock`, "RuntimeError: Attempt to unlock a mutex which is not locked", 1},
+		{`
+		m = Mutex.new
+		m.lock
+		m.unlock
+		m.unlock
+		`, "RuntimeError: Attempt to unlock a mutex which is not locked", 1},
+		{`Mutex.new.synchronize`, "InternalError: Can't yield without a block", 1},
+		{`Mutex.new.synchronize(1) do end`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 
 	"github.com/goby-lang/goby/compiler/bytecode"
@@ -24,6 +25,8 @@ type Object interface {
 	instanceVariables() *environment
 	setInstanceVariables(*environment)
 	isTruthy() bool
+	isFrozen() bool
+	freeze()
 }
 
 // BaseObj ==============================================================
@@ -32,6 +35,7 @@ type BaseObj struct {
 	class             *RClass
 	singletonClass    *RClass
 	InstanceVariables *environment
+	frozen            bool
 }
 
 func NewBaseObject(v *VM, class string) *BaseObj {
@@ -85,6 +89,14 @@ func (b *BaseObj) setInstanceVariables(e *environment) {
 	b.InstanceVariables = e
 }
 
+func (b *BaseObj) isFrozen() bool {
+	return b.frozen
+}
+
+func (b *BaseObj) freeze() {
+	b.frozen = true
+}
+
 func (b *BaseObj) findMethod(methodName string) (method Object) {
 	if b.SingletonClass() != nil {
 		method = b.SingletonClass().lookupMethod(methodName)
@@ -127,6 +139,87 @@ func (b *BaseObj) isTruthy() bool {
 	return true
 }
 
+// isEqual evaluates the left object's `==` method with the right object and returns the result as a bool.
+// The `==` methods defined in Goby are called too.
+func (t *Thread) isEqual(left, right Object, sourceLine int) bool {
+	return t.callMethod(left, "==", sourceLine, right).isTruthy()
+}
+
+// deepEqual compares the objects with reflect.DeepEqual, except that hashes (also the ones inside arrays or hashes)
+// are equal regardless of the insertion order of their keys, and the frozen state of arrays, hashes and objects is ignored.
+func deepEqual(left, right Object) bool {
+	switch l := left.(type) {
+	case *ArrayObject:
+		r, ok := right.(*ArrayObject)
+		if !ok || l.splat != r.splat || len(l.Elements) != len(r.Elements) || !equalBase(l.BaseObj, r.BaseObj) {
+			return false
+		}
+
+		for i, e := range l.Elements {
+			if !deepEqual(e, r.Elements[i]) {
+				return false
+			}
+		}
+		return true
+	case *ConcurrentArrayObject:
+		r, ok := right.(*ConcurrentArrayObject)
+		return ok && equalBase(l.BaseObj, r.BaseObj) && deepEqual(l.InternalArray, r.InternalArray)
+	case *HashObject:
+		r, ok := right.(*HashObject)
+		if !ok || len(l.Pairs) != len(r.Pairs) || !equalBase(l.BaseObj, r.BaseObj) {
+			return false
+		}
+
+		if (l.Default == nil) != (r.Default == nil) || l.Default != nil && !deepEqual(l.Default, r.Default) {
+			return false
+		}
+
+		for k, v := range l.Pairs {
+			rv, ok := r.Pairs[k]
+			if !ok || !deepEqual(v, rv) {
+				return false
+			}
+		}
+		return true
+	case *RObject:
+		r, ok := right.(*RObject)
+		return ok && l.InitializeMethod == r.InitializeMethod && equalBase(l.BaseObj, r.BaseObj)
+	default:
+		return reflect.DeepEqual(left, right)
+	}
+}
+
+// equalBase compares the classes and the instance variables of the objects, but not their frozen state.
+// An object without instance variables equals one with an empty set of them.
+func equalBase(left, right *BaseObj) bool {
+	if left.class != right.class || left.singletonClass != right.singletonClass {
+		return false
+	}
+
+	var l, r map[string]Object
+
+	if left.InstanceVariables != nil {
+		l = left.InstanceVariables.store
+	}
+
+	if right.InstanceVariables != nil {
+		r = right.InstanceVariables.store
+	}
+
+	if len(l) != len(r) {
+		return false
+	}
+
+	for name, v := range l {
+		rv, ok := r[name]
+		if !ok || !deepEqual(v, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Pointer ==============================================================
 
 // Pointer is used to point to an object. Variables should hold pointer instead of holding a object directly.
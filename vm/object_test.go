@@ -1,14 +1,17 @@
 package vm
 
-import "testing"
+import (
+	"bytes"
+	"testing"
+)
 
 func TestObjectClassSuperclass(t *testing.T) {
 	tests := []struct {
 		input    string
-		expected string
+		expected interface{}
 	}{
 		{`Object.class.name`, "Class"},
-		{`Object.superclass.name`, "Object"},
+		{`Object.superclass`, nil},
 	}
 
 	for i, tt := range tests {
@@ -66,6 +69,70 @@ func TestObjectTapMethodFail(t *testing.T) {
 	}
 }
 
+func TestObjectThenMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		1.then do |int|
+			int + 1
+		end
+		`, 2},
+		{`
+		[1, 2, 3].then do |a|
+			a.length
+		end
+		`, 3},
+		{`
+		"Goby".yield_self do |s|
+			s + "!"
+		end
+		`, "Goby!"},
+		{`
+		a = 1
+		a.then do |int|
+			int + 1
+		end
+		a
+		`, 1},
+		{`
+		[1, 2, 3].tap do |a|
+			a.length
+		end.map do |i|
+			i * 2
+		end.then do |a|
+			a.last
+		end
+		`, 6},
+		{`5.then do end`, nil},
+		{`5.yield_self do end`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestObjectThenMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Object.new.then`, "InternalError: Can't yield without a block", 1},
+		{`1.yield_self`, "InternalError: Can't yield without a block", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestObjectDupMethod(t *testing.T) {
 	setup := `
 class Student
@@ -125,3 +192,398 @@ s2.inspect
 		v.checkSP(t, i, 1)
 	}
 }
+
+func TestObjectCloneMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2, 3].clone`, []interface{}{1, 2, 3}},
+		{`
+		a = [1, 2, 3]
+		b = a.clone
+		b.push(4)
+		a
+		`, []interface{}{1, 2, 3}},
+		{`
+		a = [[1], 2]
+		b = a.clone
+		b[0].push(3)
+		a.to_s
+		`, "[[1, 3], 2]"},
+		{`
+		s = "foo"
+		c = s.clone
+		c.upcase!
+		s + c
+		`, "fooFOO"},
+		{`
+		h = { a: 1 }
+		c = h.clone
+		c[:b] = 2
+		h.to_s
+		`, "{ a: 1 }"},
+		{`1.clone`, 1},
+		{`1.5.clone`, 1.5},
+		{`(1..3).clone.to_s`, "(1..3)"},
+		{`"1.5".to_d.clone.to_s`, "1.5"},
+		{`nil.clone`, nil},
+		{`true.clone`, true},
+		{`
+		a = [1, 2]
+		a.clone.object_id == a.object_id
+		`, false},
+		{`
+		s = "foo"
+		s.clone.object_id == s.object_id
+		`, false},
+		{`
+		i = 10
+		i.clone.object_id == i.object_id
+		`, false},
+		{`
+		class Foo; end
+		f = Foo.new
+		f.clone.object_id == f.object_id
+		`, false},
+		{`
+		class Foo; end
+		Foo.new.clone.class.name
+		`, "Foo"},
+		{`
+		s = "foo"
+		s.instance_variable_set("@bar", 1)
+		s.clone.instance_variable_get("@bar")
+		`, 1},
+		{`
+		class Foo
+		  attr_accessor :bar
+		end
+
+		f = Foo.new
+		f.bar = 1
+		c = f.clone
+		c.bar = 2
+		[f.bar, c.bar]
+		`, []interface{}{1, 2}},
+		{`
+		s = "foo"
+
+		def s.shout
+		  upcase + "!"
+		end
+
+		s.clone.shout
+		`, "FOO!"},
+		{`
+		class Foo; end
+		f = Foo.new
+
+		def f.bar
+		  1
+		end
+
+		c = f.clone
+
+		def c.bar
+		  2
+		end
+
+		[f.bar, c.bar]
+		`, []interface{}{1, 2}},
+		{`
+		class Foo
+		  attr_accessor :bar
+
+		  def dup
+		    "overridden"
+		  end
+		end
+
+		f = Foo.new
+		f.bar = 1
+		f.clone.bar
+		`, 1},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestObjectCloneMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`[1].clone(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`Mutex.new.clone`, "TypeError: Can't clone Mutex", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestObjectFreezeMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[1, 2].frozen?`, false},
+		{`[1, 2].freeze.frozen?`, true},
+		{`[1, 2].freeze`, []interface{}{1, 2}},
+		{`"foo".frozen?`, false},
+		{`"foo".freeze.frozen?`, true},
+		{`{ a: 1 }.freeze.frozen?`, true},
+		{`1.frozen?`, true},
+		{`1.5.frozen?`, true},
+		{`"1.5".to_d.frozen?`, true},
+		{`true.frozen?`, true},
+		{`nil.frozen?`, true},
+		{`:a.frozen?`, true},
+		{`"a".frozen?`, false},
+		{`[:a, "b"].map do |s| s.frozen? end`, []interface{}{true, false}},
+		{`(:a + "b").frozen?`, false},
+		{`
+		a = [1, 2]
+		b = a
+		a.freeze
+		b.frozen?
+		`, true},
+		{`
+		a = [1, 2].freeze
+		a.map do |i|
+		  i * 2
+		end
+		`, []interface{}{2, 4}},
+		{`
+		a = [1, 2].freeze
+		b = a.dup
+		b.push(3)
+		[a.length, b.length, b.frozen?]
+		`, []interface{}{2, 3, false}},
+		{`[1, 2].freeze == [1, 2]`, true},
+		{`[1, 2] == [1, 2].freeze`, true},
+		{`[1, 2].freeze == [1, 3]`, false},
+		{`{ a: 1 }.freeze == { a: 1 }`, true},
+		{`{ a: 1 }.eql?({ a: 1 }.freeze)`, true},
+		{`[[1, 2].freeze].include?([1, 2])`, true},
+		{`{ a: [1].freeze }.has_value?([1])`, true},
+		{`[2, 1].sort == [1, 2]`, true},
+		{`
+		class Foo
+		  def initialize(x)
+		    @x = x
+		  end
+		end
+		[Foo.new(1).freeze == Foo.new(1), Foo.new(1).freeze == Foo.new(2)]
+		`, []interface{}{true, false}},
+		{`[1, 2].freeze.clone.frozen?`, true},
+		{`[1, 2].clone.frozen?`, false},
+		{`
+		s = "foo".freeze
+		s.upcase
+		`, "FOO"},
+		{`
+		class Foo; end
+		Foo.new.freeze.frozen?
+		`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestObjectFreezeMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`a = [1, 2]
+		a.freeze
+		a.push(1)`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze[0] = 3`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze.pop`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze.shift`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze.unshift(0)`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze.concat([3])`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze.append(3)`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze.delete_at(0)`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze.reverse!`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`[1, 2].freeze.clear`, "FrozenError: Can't modify frozen Array: [1, 2]", 1},
+		{`"foo".freeze.upcase!`, "FrozenError: Can't modify frozen String: \"foo\"", 1},
+		{`" foo ".freeze.strip!`, "FrozenError: Can't modify frozen String: \" foo \"", 1},
+		{`{ a: 1 }.freeze[:b] = 2`, "FrozenError: Can't modify frozen Hash: { a: 1 }", 1},
+		{`{ a: 1 }.freeze.delete(:a)`, "FrozenError: Can't modify frozen Hash: { a: 1 }", 1},
+		{`{ a: 1 }.freeze.merge!({ b: 2 })`, "FrozenError: Can't modify frozen Hash: { a: 1 }", 1},
+		{`[1].freeze(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`[1].frozen?(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestObjectFreezeInstanceVariablesFail(t *testing.T) {
+	class := `
+	class Foo
+	  attr_writer :x
+
+	  def set_y(y)
+	    @y = y
+	  end
+	end
+	`
+
+	testsFail := []struct {
+		input       string
+		expected    string
+		expectedCFP int
+		expectedSP  int
+	}{
+		{`Foo.new.freeze.x = 1`, "FrozenError: Can't modify frozen Foo: #<Foo:##OBJECTID## >", 1, 1},
+		// The frame of `set_y` and its argument are left when the error is raised inside the method
+		{`Foo.new.freeze.set_y(1)`, "FrozenError: Can't modify frozen Foo: #<Foo:##OBJECTID## >", 2, 3},
+		{`Foo.new.freeze.instance_variable_set("@z", 1)`, "FrozenError: Can't modify frozen Foo: #<Foo:##OBJECTID## >", 1, 1},
+		{`:a.upcase!`, "FrozenError: Can't modify frozen String: \"a\"", 1, 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, class+tt.input, getFilename())
+		checkFuzzifiedErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, tt.expectedSP)
+	}
+}
+
+func TestObjectPutsMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`puts("foo")`, "foo\n"},
+		{`puts("foo", 1)`, "foo\n1\n"},
+		{`puts`, "\n"},
+		{`puts([1, 2])`, "1\n2\n"},
+		{`puts([1, [2, 3]], "a")`, "1\n2\n3\na\n"},
+		{`puts([])`, "\n"},
+		{`
+		a = [1]
+		a.push(a)
+		puts(a)
+		`, "1\n[...]\n"},
+		{`
+		a = [1]
+		puts([a, a])
+		`, "1\n1\n"},
+		{`puts(nil)`, "\n"},
+		{`print("foo", 1, "bar")`, "foo1bar"},
+		{`print`, ""},
+		{`p("foo", 1)`, "\"foo\"\n1\n"},
+		{`p([1, "a"])`, "[1, \"a\"]\n"},
+		{`p`, ""},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		var out bytes.Buffer
+		v.SetOutput(&out)
+		v.testEval(t, tt.input, getFilename())
+
+		if out.String() != tt.expected {
+			t.Errorf("At case %d expect output to be %q. got: %q", i, tt.expected, out.String())
+		}
+
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestObjectPutsMethodReturnValue(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`puts("foo")`, nil},
+		{`print("foo")`, nil},
+		{`p`, nil},
+		{`p("foo")`, "foo"},
+		{`p(1, 2).length`, 2},
+		{`p([1, 2]).length`, 2},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetOutput(&bytes.Buffer{})
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestObjectSprintfMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`sprintf("%05d", 42)`, "00042"},
+		{`sprintf("%.2f", 3.14159)`, "3.14"},
+		{`sprintf("%d", 3.7)`, "3"},
+		{`sprintf("%i", -4)`, "-4"},
+		{`sprintf("%+d % d", 3, 4)`, "+3  4"},
+		{`sprintf("%x %X %o %b", 255, 255, 8, 5)`, "ff FF 10 101"},
+		{`sprintf("%#x %08b", 255, 5)`, "0xff 00000101"},
+		{`sprintf("%5.1f|%-6.2f|", 2.25, 1)`, "  2.2|1.00  |"},
+		{`sprintf("%.2f", "1.005".to_d)`, "1.00"},
+		{`sprintf("%e", 12345.678)`, "1.234568e+04"},
+		{`sprintf("%s and %s", "Goby", 1)`, "Goby and 1"},
+		{`sprintf("%-5s|%5s|", "ab", "cd")`, "ab   |   cd|"},
+		{`sprintf("%.3s", "abcdef")`, "abc"},
+		{`sprintf("100%%")`, "100%"},
+		{`format("%s is %d", "Goby", 5)`, "Goby is 5"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestObjectSprintfMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`sprintf`, "ArgumentError: Expect 1 or more argument(s). got: 0", 1},
+		{`sprintf(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`sprintf("%d", "Goby")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`sprintf("%f", nil)`, "TypeError: Expect argument to be Numeric. got: Null", 1},
+		{`sprintf("%s %s", "Goby")`, "ArgumentError: Expect 2 argument(s). got: 1", 1},
+		{`sprintf("%s", "Goby", "Ruby")`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`sprintf("%q", 1)`, "ArgumentError: Malformed format string: %q", 1},
+		{`format("%d")`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
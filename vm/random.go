@@ -0,0 +1,218 @@
+package vm
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// RandomObject is a pseudo-random number generator.
+// Generators created with the same seed produce the same sequence of numbers.
+//
+// ```ruby
+// r = Random.new(42)
+// r.rand      # a Float between 0.0 and 1.0
+// r.rand(100) # an Integer between 0 and 99
+// Random.new(42).rand(100) == Random.new(42).rand(100) #=> true
+// ```
+//
+// `Random.rand` uses the VM's default generator, which is also used by `Array#sample` and `Array#shuffle`.
+type RandomObject struct {
+	*BaseObj
+	seed   int64
+	random *rand.Rand
+	lock   sync.Mutex
+}
+
+// Class methods --------------------------------------------------------
+var builtinRandomClassMethods = []*BuiltinMethodObject{
+	{
+		// Creates a generator with the given seed.
+		// If the seed is omitted, a seed is taken from the default generator.
+		//
+		// ```ruby
+		// Random.new(42).seed #=> 42
+		// ```
+		//
+		// @param seed [Integer]
+		// @return [Random]
+		Name: "new",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			var seed int64
+
+			switch len(args) {
+			case 0:
+				t.vm.randomLock.Lock()
+				seed = t.vm.random.Int63()
+				t.vm.randomLock.Unlock()
+			case 1:
+				s, ok := args[0].(*IntegerObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+				}
+				seed = int64(s.value)
+			default:
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, len(args))
+			}
+
+			return t.vm.initRandomObject(seed)
+
+		},
+	},
+	{
+		// Returns a random number from the VM's default generator.
+		// See `Random#rand` for the arguments.
+		//
+		// ```ruby
+		// Random.rand     # a Float between 0.0 and 1.0
+		// Random.rand(10) # an Integer between 0 and 9
+		// ```
+		//
+		// @param limit [Integer/Float/Range]
+		// @return [Integer/Float]
+		Name: "rand",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return randomNumber(t, args, sourceLine, &t.vm.random, &t.vm.randomLock)
+
+		},
+	},
+}
+
+// Instance methods -----------------------------------------------------
+var builtinRandomInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the next random number of the generator.
+		//
+		// - Without an argument, returns a Float that is greater than or equal to 0.0 and less than 1.0.
+		// - With an Integer `n`, returns an Integer that is greater than or equal to 0 and less than `n`.
+		// - With a Float `f`, returns a Float that is greater than or equal to 0.0 and less than `f`.
+		// - With a Range, returns an Integer in the range.
+		//
+		// The limit should be positive and the range should not be empty, or an ArgumentError is raised.
+		//
+		// ```ruby
+		// r = Random.new(42)
+		// r.rand        # a Float between 0.0 and 1.0
+		// r.rand(6)     # an Integer between 0 and 5
+		// r.rand(1.5)   # a Float between 0.0 and 1.5
+		// r.rand(1..6)  # an Integer between 1 and 6
+		// ```
+		//
+		// @param limit [Integer/Float/Range]
+		// @return [Integer/Float]
+		Name: "rand",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			r := receiver.(*RandomObject)
+			return randomNumber(t, args, sourceLine, &r.random, &r.lock)
+
+		},
+	},
+	{
+		// Returns the seed of the generator.
+		//
+		// ```ruby
+		// Random.new(42).seed #=> 42
+		// ```
+		//
+		// @return [Integer]
+		Name: "seed",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			return t.vm.InitIntegerObject(int(receiver.(*RandomObject).seed))
+
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initRandomObject(seed int64) *RandomObject {
+	return &RandomObject{
+		BaseObj: &BaseObj{class: vm.TopLevelClass(classes.RandomClass)},
+		seed:    seed,
+		random:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (vm *VM) initRandomClass() *RClass {
+	rc := vm.initializeClass(classes.RandomClass)
+	rc.setBuiltinMethods(builtinRandomClassMethods, true)
+	rc.setBuiltinMethods(builtinRandomInstanceMethods, false)
+	return rc
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the object
+func (r *RandomObject) Value() interface{} {
+	return r.random
+}
+
+// ToString returns the object's name as the string format
+func (r *RandomObject) ToString() string {
+	return "#<Random>"
+}
+
+// Inspect delegates to ToString
+func (r *RandomObject) Inspect() string {
+	return r.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (r *RandomObject) ToJSON(t *Thread) string {
+	return r.ToString()
+}
+
+// Other helper functions -----------------------------------------------
+
+// randomNumber returns a random number from the generator according to the limit in args; common to `Random.rand` and `Random#rand`.
+// The generator is only read after taking the lock, since the VM's default generator can be replaced by SetRandom.
+func randomNumber(t *Thread, args []Object, sourceLine int, generator **rand.Rand, lock *sync.Mutex) Object {
+	if len(args) > 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, len(args))
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	r := *generator
+
+	if len(args) == 0 {
+		return t.vm.initFloatObject(r.Float64())
+	}
+
+	switch limit := args[0].(type) {
+	case *IntegerObject:
+		if limit.value <= 0 {
+			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidRandomLimit, limit.ToString())
+		}
+
+		return t.vm.InitIntegerObject(r.Intn(limit.value))
+	case *FloatObject:
+		if limit.value <= 0 {
+			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidRandomLimit, limit.ToString())
+		}
+
+		return t.vm.initFloatObject(r.Float64() * limit.value)
+	case *RangeObject:
+		size := limit.End - limit.Start + 1
+		if limit.Exclusive {
+			size--
+		}
+
+		if size <= 0 {
+			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidRandomLimit, limit.ToString())
+		}
+
+		return t.vm.InitIntegerObject(limit.Start + r.Intn(size))
+	default:
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Integer, Float or Range", args[0].Class().Name)
+	}
+}
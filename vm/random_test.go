@@ -0,0 +1,209 @@
+package vm
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomNewMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Random.new(42).seed`, 42},
+		{`Random.new(-1).seed`, -1},
+		{`Random.new.class.name`, "Random"},
+		{`Random.new(42).to_s`, "#<Random>"},
+		{`Random.new.seed == Random.new.seed`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRandomNewMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Random.new("42")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`Random.new(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`Random.new(1).seed(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRandomRandMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Random.new(42).rand(100)`, 5},
+		{`
+		r = Random.new(42)
+		[r.rand(100), r.rand(100)]
+		`, []interface{}{5, 87}},
+		{`Random.new(42).rand`, 0.3730283610466326},
+		{`Random.new(42).rand(1..6)`, 6},
+		{`Random.new(7).rand == Random.new(7).rand`, true},
+		{`Random.new(7).rand(1.5) == Random.new(7).rand(1.5)`, true},
+		{`
+		r = Random.new(1)
+		results = []
+		100.times do
+		  n = r.rand(3)
+		  if n < 0 || n > 2
+		    results.push(n)
+		  end
+		end
+		results
+		`, []interface{}{}},
+		{`
+		r = Random.new(1)
+		zero = 0.0
+		limit = 2.5
+		results = []
+		100.times do
+		  n = r.rand(limit)
+		  if n < zero || n >= limit
+		    results.push(n)
+		  end
+		end
+		results
+		`, []interface{}{}},
+		{`
+		r = Random.new(1)
+		results = []
+		100.times do
+		  n = r.rand(-2..2)
+		  if n < -2 || n > 2
+		    results.push(n)
+		  end
+		end
+		results
+		`, []interface{}{}},
+		{`
+		r = Random.new(1)
+		results = []
+		100.times do
+		  n = r.rand(1...3)
+		  if n < 1 || n > 2
+		    results.push(n)
+		  end
+		end
+		results
+		`, []interface{}{}},
+		{`Random.new(1).rand(5..5)`, 5},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRandomRandMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Random.new(1).rand(0)`, "ArgumentError: Invalid limit for random numbers. got: 0", 1},
+		{`Random.new(1).rand(-1)`, "ArgumentError: Invalid limit for random numbers. got: -1", 1},
+		{`Random.new(1).rand(0.0)`, "ArgumentError: Invalid limit for random numbers. got: 0.0", 1},
+		{`Random.new(1).rand(3..1)`, "ArgumentError: Invalid limit for random numbers. got: (3..1)", 1},
+		{`Random.new(1).rand(1...1)`, "ArgumentError: Invalid limit for random numbers. got: (1...1)", 1},
+		{`Random.new(1).rand("1")`, "TypeError: Expect argument to be Integer, Float or Range. got: String", 1},
+		{`Random.new(1).rand(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`Random.rand(0)`, "ArgumentError: Invalid limit for random numbers. got: 0", 1},
+		{`Random.rand(1, 2)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRandomClassRandMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Random.rand(100)`, 5},
+		{`Random.rand`, 0.3730283610466326},
+		{`Random.rand(1..6)`, 6},
+		{`
+		Random.rand(100)
+		Random.rand(100)
+		`, 87},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetRandomSeed(42)
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRandomInjectedDefaultGenerator(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Random.rand(100)`, 5},
+		{`Random.new.seed`, int(rand.New(rand.NewSource(42)).Int63())},
+		{`[1, 2, 3, 4, 5].shuffle`, []interface{}{3, 4, 5, 1, 2}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetRandom(rand.New(rand.NewSource(42)))
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRandomSetRandomWhileThreadRuns(t *testing.T) {
+	v := initTestVM()
+	done := make(chan bool)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				v.SetRandom(rand.New(rand.NewSource(42)))
+			}
+		}
+	}()
+
+	evaluated := v.testEval(t, `
+	t = thread do
+	  100.times do
+	    Random.rand(10)
+	  end
+	end
+	t.join
+	`, getFilename())
+	close(done)
+
+	VerifyExpected(t, 0, evaluated, 100)
+}
@@ -9,6 +9,7 @@ import (
 
 // RangeObject is the built in range class
 // Range represents an interval: a set of values from the beginning to the end specified.
+// A two-dot range `1..5` includes the end, while a three-dot range `1...5` excludes it.
 // Currently, only Integer objects or integer literal are supported.
 //
 // ```ruby
@@ -29,8 +30,9 @@ import (
 //
 type RangeObject struct {
 	*BaseObj
-	Start int
-	End   int
+	Start     int
+	End       int
+	Exclusive bool
 }
 
 // Class methods --------------------------------------------------------
@@ -50,8 +52,9 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		// Returns a Boolean of compared two ranges
 		//
 		// ```ruby
-		// (1..5) == (1..5) # => true
-		// (1..5) == (1..6) # => false
+		// (1..5) == (1..5)  # => true
+		// (1..5) == (1..6)  # => false
+		// (1..5) == (1...5) # => false
 		// ```
 		//
 		// @return [Boolean]
@@ -66,7 +69,7 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 				return FALSE
 			}
 
-			if left.Start == right.Start && left.End == right.End {
+			if left.equal(right) {
 				return TRUE
 			}
 
@@ -78,8 +81,9 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		// Returns a Boolean of compared two ranges
 		//
 		// ```ruby
-		// (1..5) != (1..5) # => false
-		// (1..5) != (1..6) # => true
+		// (1..5) != (1..5)  # => false
+		// (1..5) != (1..6)  # => true
+		// (1..5) != (1...5) # => true
 		// ```
 		//
 		// @return [Boolean]
@@ -92,7 +96,7 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 			}
 
 			left := receiver.(*RangeObject)
-			if left.Start == right.Start && left.End == right.End {
+			if left.equal(right) {
 				return FALSE
 			}
 
@@ -144,18 +148,19 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		Name: "bsearch",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 			ro := receiver.(*RangeObject)
+			last := ro.last()
 
-			if ro.Start < 0 || ro.End < 0 {
+			if ro.size() == 0 || ro.Start < 0 || last < 0 {
 				// if block is not used, it should be popped
 				t.callFrameStack.pop()
 				return NULL
 			}
 
 			var start, end int
-			if ro.Start < ro.End {
-				start, end = ro.Start, ro.End
+			if ro.Start < last {
+				start, end = ro.Start, last
 			} else {
-				start, end = ro.End, ro.Start
+				start, end = last, ro.Start
 			}
 
 			// the element of the range
@@ -230,11 +235,16 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		//   sum = sum + i
 		// end
 		// sum # => -15
+		//
+		// sum = 0
+		// (1...5).each do |i|
+		//   sum = sum + i
+		// end
+		// sum # => 10
 		// ```
 		//
 		// **Note:**
 		// - Only `do`-`end` block is supported: `{ }` block is unavailable.
-		// - Three-dot range `...` is not supported yet.
 		//
 		// @return [Range]
 		Name: "each",
@@ -245,6 +255,11 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
 			}
 
+			// If there's nothing to yield, pop the block's call frame
+			if ro.size() == 0 {
+				t.callFrameStack.pop()
+			}
+
 			ro.each(func(i int) error {
 				obj := t.vm.InitIntegerObject(i)
 				t.builtinMethodYield(blockFrame, obj)
@@ -256,6 +271,21 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns true if the range excludes its end, which is the case for a three-dot range.
+		//
+		// ```ruby
+		// (1..5).exclude_end?  # => false
+		// (1...5).exclude_end? # => true
+		// ```
+		//
+		// @return [Boolean]
+		Name: "exclude_end?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return toBooleanObject(receiver.(*RangeObject).Exclusive)
+
+		},
+	},
 	{
 		// Returns the first value of the range.
 		//
@@ -288,6 +318,8 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		// (1..-5).include?(-2)  # => true
 		// (-2..-5).include?(-2) # => true
 		// (-3..-5).include?(-2) # => false
+		// (5...10).include?(10) # => false
+		// (5...10).include?(9)  # => true
 		// ```
 		//
 		// @param number [Integer]
@@ -301,8 +333,13 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 			ro := receiver.(*RangeObject)
 
 			value := args[0].(*IntegerObject).value
-			ascendRangeBool := ro.Start <= ro.End && value >= ro.Start && value <= ro.End
-			descendRangeBool := ro.End <= ro.Start && value <= ro.Start && value >= ro.End
+			if ro.size() == 0 {
+				return FALSE
+			}
+
+			last := ro.last()
+			ascendRangeBool := ro.Start <= last && value >= ro.Start && value <= last
+			descendRangeBool := last <= ro.Start && value <= ro.Start && value >= last
 
 			if ascendRangeBool || descendRangeBool {
 				return TRUE
@@ -312,13 +349,14 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns the last value of the range.
+		// Returns the last value of the range, which is the end even for a three-dot range.
 		//
 		// ```ruby
 		// (1..5).last   # => 5
 		// (5..1).last   # => 1
 		// (-2..3).last  # => 3
 		// (-5..-7).last # => -7
+		// (1...5).last  # => 5
 		// ```
 		//
 		// @return [Integer]
@@ -350,7 +388,12 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 			}
 
 			ro := receiver.(*RangeObject)
-			var el []Object
+			el := []Object{}
+
+			// If there's nothing to yield, pop the block's call frame
+			if ro.size() == 0 && !blockIsEmpty(blockFrame) {
+				t.callFrameStack.pop()
+			}
 
 			ro.each(func(i int) error {
 				if blockIsEmpty(blockFrame) {
@@ -375,17 +418,14 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		// (3..9).size   # => 7
 		// (-1..-5).size # => 5
 		// (-1..7).size  # => 9
+		// (1...5).size  # => 4
+		// (1...1).size  # => 0
 		// ```
 		//
 		// @return [Integer]
 		Name: "size",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			ro := receiver.(*RangeObject)
-
-			if ro.Start <= ro.End {
-				return t.vm.InitIntegerObject(ro.End - ro.Start + 1)
-			}
-			return t.vm.InitIntegerObject(ro.Start - ro.End + 1)
+			return t.vm.InitIntegerObject(receiver.(*RangeObject).size())
 
 		},
 	},
@@ -468,24 +508,19 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		// (1..5).to_a[2]  # => 3
 		// (-1..-5).to_a   # => [-1, -2, -3, -4, -5]
 		// (-1..3).to_a    # => [-1, 0, 1, 2, 3]
+		// (1...5).to_a    # => [1, 2, 3, 4]
 		// ```
 		//
 		// @return [Array]
 		Name: "to_a",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			var offset int
-
 			ro := receiver.(*RangeObject)
-			if ro.Start <= ro.End {
-				offset = 1
-			} else {
-				offset = -1
-			}
 
 			el := []Object{}
-			for i := ro.Start; i != (ro.End + offset); i += offset {
+			ro.each(func(i int) error {
 				el = append(el, t.vm.InitIntegerObject(i))
-			}
+				return nil
+			})
 
 			return t.vm.InitArrayObject(el)
 
@@ -497,6 +532,7 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 		// ```ruby
 		// (1..5).to_s   # "(1..5)"
 		// (-1..-3).to_s # "(-1..-3)"
+		// (1...5).to_s  # "(1...5)"
 		// ```
 		//
 		// @return [String]
@@ -514,11 +550,12 @@ var builtinRangeInstanceMethods = []*BuiltinMethodObject{
 
 // Functions for initialization -----------------------------------------
 
-func (vm *VM) initRangeObject(start, end int) *RangeObject {
+func (vm *VM) initRangeObject(start, end int, exclusive bool) *RangeObject {
 	return &RangeObject{
-		BaseObj: &BaseObj{class: vm.TopLevelClass(classes.RangeClass)},
-		Start:   start,
-		End:     end,
+		BaseObj:   &BaseObj{class: vm.TopLevelClass(classes.RangeClass)},
+		Start:     start,
+		End:       end,
+		Exclusive: exclusive,
 	}
 }
 
@@ -535,6 +572,9 @@ func (vm *VM) initRangeClass() *RClass {
 
 // ToString returns the object's name as the string format
 func (ro *RangeObject) ToString() string {
+	if ro.Exclusive {
+		return fmt.Sprintf("(%d...%d)", ro.Start, ro.End)
+	}
 	return fmt.Sprintf("(%d..%d)", ro.Start, ro.End)
 }
 
@@ -553,7 +593,42 @@ func (ro *RangeObject) Value() interface{} {
 	return ro.ToString()
 }
 
+// equal checks if the bounds and the exclusiveness of the ranges are the same
+func (ro *RangeObject) equal(other *RangeObject) bool {
+	return ro.Start == other.Start && ro.End == other.End && ro.Exclusive == other.Exclusive
+}
+
+// size returns the number of integers in the range
+func (ro *RangeObject) size() int {
+	size := ro.End - ro.Start
+	if size < 0 {
+		size = -size
+	}
+
+	if ro.Exclusive {
+		return size
+	}
+	return size + 1
+}
+
+// last returns the last integer in the range, which is next to the end for an exclusive range.
+// The range should not be empty.
+func (ro *RangeObject) last() int {
+	switch {
+	case !ro.Exclusive:
+		return ro.End
+	case ro.Start < ro.End:
+		return ro.End - 1
+	default:
+		return ro.End + 1
+	}
+}
+
 func (ro *RangeObject) each(f func(int) error) (err error) {
+	if ro.size() == 0 {
+		return
+	}
+
 	var inc int
 	if ro.End-ro.Start >= 0 {
 		inc = 1
@@ -561,7 +636,8 @@ func (ro *RangeObject) each(f func(int) error) (err error) {
 		inc = -1
 	}
 
-	for i := ro.Start; i != ro.End+inc; i += inc {
+	last := ro.last()
+	for i := ro.Start; i != last+inc; i += inc {
 		if err = f(i); err != nil {
 			return err
 		}
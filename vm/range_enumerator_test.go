@@ -35,6 +35,45 @@ func TestRangeEnumeratorEnumerationWithElements(t *testing.T) {
 		`,
 			[]interface{}{3, 2, 1},
 		},
+		{`
+		iterated_values = []
+	
+		enumerator = RangeEnumerator.new((1...3))
+	
+		while enumerator.has_next? do
+			iterated_values.push(enumerator.next)
+		end
+	
+		iterated_values
+		`,
+			[]interface{}{1, 2},
+		},
+		{`
+		iterated_values = []
+	
+		enumerator = RangeEnumerator.new((3...1))
+	
+		while enumerator.has_next? do
+			iterated_values.push(enumerator.next)
+		end
+	
+		iterated_values
+		`,
+			[]interface{}{3, 2},
+		},
+		{`
+		iterated_values = []
+	
+		enumerator = RangeEnumerator.new((1...1))
+	
+		while enumerator.has_next? do
+			iterated_values.push(enumerator.next)
+		end
+	
+		iterated_values
+		`,
+			[]interface{}{},
+		},
 	}
 
 	for i, tt := range tests {
@@ -45,6 +45,10 @@ func TestRangeComparisonOperation(t *testing.T) {
 		{`(1..3) != [1, "String", true, 2..5]`, true},
 		{`(1..3) != Integer`, true},
 		{`(3..1) != Integer`, true},
+		{`(1...3) == (1...3)`, true},
+		{`(1...3) == (1..3)`, false},
+		{`(1..3) != (1...3)`, true},
+		{`(1...3) != (1...3)`, false},
 	}
 
 	for i, tt := range tests {
@@ -189,6 +193,23 @@ func TestRangeBsearchMethod(t *testing.T) {
 			0 - ary[i]
 		end
 		`, nil},
+		{`
+		ary = [0, 4, 7, 10, 12]
+		(0...4).bsearch do |i|
+			ary[i] >= 12
+		end
+		`, nil},
+		{`
+		ary = [0, 4, 7, 10, 12]
+		(0...4).bsearch do |i|
+			ary[i] >= 10
+		end
+		`, 3},
+		{`
+		(0...0).bsearch do |i|
+			true
+		end
+		`, nil},
 	}
 
 	for i, tt := range tests {
@@ -266,6 +287,27 @@ func TestRangeEachMethod(t *testing.T) {
 		end
 		r
 		`, -15},
+		{`
+		r = 0
+		(1...5).each do |i|
+		  r = r + i
+		end
+		r
+		`, 10},
+		{`
+		r = 0
+		(5...1).each do |i|
+		  r = r + i
+		end
+		r
+		`, 14},
+		{`
+		r = 0
+		(1...1).each do |i|
+		  r = r + i
+		end
+		r
+		`, 0},
 	}
 
 	for i, tt := range tests {
@@ -293,6 +335,25 @@ func TestRangeEachMethodFail(t *testing.T) {
 	}
 }
 
+func TestRangeExcludeEndMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`(1..5).exclude_end?`, false},
+		{`(1...5).exclude_end?`, true},
+		{`(5...1).exclude_end?`, true},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestRangeFirstMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -310,6 +371,9 @@ func TestRangeFirstMethod(t *testing.T) {
 		{`
 		(-5..-7).first
 		`, -5},
+		{`
+		(1...5).first
+		`, 1},
 	}
 
 	for i, tt := range tests {
@@ -359,6 +423,21 @@ func TestRangeIncludeMethod(t *testing.T) {
 		{`
 		(-3..-5).include?(-2)
 		`, false},
+		{`
+		(5...10).include?(10)
+		`, false},
+		{`
+		(5...10).include?(9)
+		`, true},
+		{`
+		(10...5).include?(5)
+		`, false},
+		{`
+		(10...5).include?(10)
+		`, true},
+		{`
+		(5...5).include?(5)
+		`, false},
 	}
 
 	for i, tt := range tests {
@@ -402,6 +481,9 @@ func TestRangeLastMethod(t *testing.T) {
 		{`
 		(-5..-7).last
 		`, -7},
+		{`
+		(1...5).last
+		`, 5},
 	}
 
 	for i, tt := range tests {
@@ -427,6 +509,12 @@ func TestRangeMapMethod(t *testing.T) {
 		{`
 		(1..5).map do |x| end
 		`, []interface{}{nil, nil, nil, nil, nil}},
+		{`
+		(1...5).map do |x| x * x; end
+		`, []interface{}{1, 4, 9, 16}},
+		{`
+		(1...1).map do |x| x * x; end
+		`, []interface{}{}},
 	}
 
 	for i, tt := range tests {
@@ -475,6 +563,15 @@ func TestRangeSizeMethod(t *testing.T) {
 		{`
 		(-1..7).size
 		`, 9},
+		{`
+		(1...5).size
+		`, 4},
+		{`
+		(5...1).size
+		`, 4},
+		{`
+		(1...1).size
+		`, 0},
 	}
 
 	for i, tt := range tests {
@@ -535,6 +632,13 @@ func TestRangeStepMethod(t *testing.T) {
 		 end
 		 sum
 		`, -9},
+		{`
+		sum = 0
+		(1...7).step(3) do |i|
+		  sum = sum + i
+		end
+		sum
+		`, 5},
 	}
 
 	for i, tt := range tests {
@@ -589,6 +693,9 @@ func TestRangeToStringMethod(t *testing.T) {
 		{`
 		(1..-5).to_s
 		`, "(1..-5)"},
+		{`
+		(1...5).to_s
+		`, "(1...5)"},
 	}
 
 	for i, tt := range tests {
@@ -623,6 +730,18 @@ func TestRangeToArrayMethod(t *testing.T) {
 		{`
 		(-1..3).to_a[2]
 		`, 1},
+		{`
+		(1...5).to_a.length
+		`, 4},
+		{`
+		(1...5).to_a[-1]
+		`, 4},
+		{`
+		(5...1).to_a[-1]
+		`, 2},
+		{`
+		(1...1).to_a.length
+		`, 0},
 	}
 
 	for i, tt := range tests {
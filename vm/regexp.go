@@ -17,6 +17,9 @@ import (
 // a.match?("Hello World")   #=> true
 // a.match?("Hello Regexp")  #=> false
 //
+// /orl/.match?("Hello World")  #=> true
+// /ORL/i.match?("Hello World") #=> true
+//
 // b = Regexp.new("😏")
 // b.match?("🤡 😏 😐")   #=> true
 // b.match?("😝 😍 😊")   #=> false
@@ -31,9 +34,9 @@ import (
 // - Currently, manipulations are based upon Golang's Unicode manipulations.
 // - Currently, UTF-8 encoding is assumed based upon Golang's string manipulation, but the encoding is not actually specified(TBD).
 // - `Regexp.new` is exceptionally supported.
+// - A regexp literal `/.../` is the same as `Regexp.new("...")`, and supports the `i`, `m` (`.` matches newlines) and `x` options.
 //
 // **To Goby maintainers**: avoid using Go's standard regexp package (slow and not rich). Consider the faster `Trim` or `Split` etc in Go's "strings" package first, or just use the dlclark/regexp2 instead.
-// ToDo: Regexp literals with '/.../'
 type Regexp = regexp2.Regexp
 type RegexpObject struct {
 	*BaseObj
@@ -51,10 +54,10 @@ var builtInRegexpClassMethods = []*BuiltinMethodObject{
 
 			arg, ok := args[0].(*StringObject)
 			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, arg.Class().Name)
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
 			}
 
-			r := t.vm.initRegexpObject(args[0].ToString())
+			r := t.vm.initRegexpObject(arg.value)
 			if r == nil {
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Invalid regexp: %v", args[0].ToString())
 			}
@@ -101,6 +104,45 @@ var builtinRegexpInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Matches the regexp with the given string, and returns the position of the first match, or nil if not matched.
+		// The position is counted in characters.
+		//
+		// ```ruby
+		// /zz/ =~ "pizza"   # => 2
+		// /^zz/ =~ "pizza"  # => nil
+		// ```
+		//
+		// @param string [String]
+		// @return [Integer]
+		Name: "=~",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			match := receiver.(*RegexpObject).match(t, args, sourceLine)
+			m, ok := match.(*MatchDataObject)
+			if !ok {
+				return match
+			}
+
+			return t.vm.InitIntegerObject(m.match.Index)
+
+		},
+	},
+	{
+		// Returns the MatchData of the first match of the regexp in the given string, or nil if not matched.
+		//
+		// ```ruby
+		// /(\d+)-(\d+)/.match("tel: 03-1234")  # => #<MatchData 0:"03-1234" 1:"03" 2:"1234">
+		// /(\d+)-(\d+)/.match("tel: none")     # => nil
+		// ```
+		//
+		// @param string [String]
+		// @return [MatchData]
+		Name: "match",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*RegexpObject).match(t, args, sourceLine)
+
+		},
+	},
 	{
 		// Returns boolean value to indicate the result of regexp match with the string given. The methods evaluates a String object.
 		//
@@ -137,8 +179,9 @@ var builtinRegexpInstanceMethods = []*BuiltinMethodObject{
 
 // Functions for initialization -----------------------------------------
 
+// initRegexpObject compiles the pattern in multiline mode, so `^` and `$` match at line boundaries as in Ruby
 func (vm *VM) initRegexpObject(regexp string) *RegexpObject {
-	r, err := regexp2.Compile(regexp, 0)
+	r, err := regexp2.Compile(regexp, regexp2.Multiline)
 	if err != nil {
 		return nil
 	}
@@ -179,5 +222,24 @@ func (r *RegexpObject) ToJSON(t *Thread) string {
 
 // equal checks if the string values between receiver and argument are equal
 func (r *RegexpObject) equal(e *RegexpObject) bool {
-	return r.ToString() == r.ToString()
+	return r.ToString() == e.ToString()
+}
+
+// match returns the MatchData of the first match in the String argument, or nil if not matched; common to `match` and `=~`
+func (r *RegexpObject) match(t *Thread, args []Object, sourceLine int) Object {
+	if len(args) != 1 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+	}
+
+	input, ok := args[0].(*StringObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+	}
+
+	match, _ := r.regexp.FindStringMatch(input.value)
+	if match == nil {
+		return NULL
+	}
+
+	return t.vm.initMatchDataObject(match, r.regexp.String(), input.value)
 }
@@ -43,6 +43,8 @@ func TestRegexpClassCreation(t *testing.T) {
 func TestRegexpNewMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`Regexp.new`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`Regexp.new(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`Regexp.new("(")`, "ArgumentError: Invalid regexp: (", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -198,6 +200,130 @@ func TestRegexpMatchMethodFail(t *testing.T) {
 	}
 }
 
+func TestRegexpLiteral(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`/abc/.class.name`, "Regexp"},
+		{`/abc/.to_s`, "abc"},
+		{`/abc/ == Regexp.new("abc")`, true},
+		{`/a\/b/.to_s`, "a/b"},
+		{`/a\/b/.match?("a/b")`, true},
+		{`/\d+/.match?("abc123")`, true},
+		{`/\d+/.match?("abc")`, false},
+		{`/ABC/i.match?("xabcx")`, true},
+		{`/ABC/.match?("xabcx")`, false},
+		{`/a.b/.match?("a\nb")`, false},
+		{`/a.b/m.match?("a\nb")`, true},
+		{`/a b c/x.match?("abc")`, true},
+		{`
+		r = [/a/, /b/]
+		r.length
+		`, 2},
+		{`
+		x = 10
+		x / 2 / 5
+		`, 1},
+		{`
+		def foo(r)
+		  r.match?("foo")
+		end
+		foo(/o+/)
+		`, true},
+		{`
+		def foo(r)
+		  r.match?("foo")
+		end
+		foo /o+/
+		`, true},
+		{`
+		x = 10
+		x /2
+		`, 5},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRegexpMatchDataMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`/o/.match("pow").to_s`, `#<MatchData 0:"o">`},
+		{`/x/.match("pow")`, nil},
+		{`/(\d+)-(\d+)/.match("tel: 03-1234").to_s`, `#<MatchData 0:"03-1234" 1:"03" 2:"1234">`},
+		{`/(\d+)-(\d+)/.match("tel: 03-1234").captures`, []interface{}{"03", "1234"}},
+		{`/(\d+)-(\d+)/.match("tel: 03-1234").to_a`, []interface{}{"03-1234", "03", "1234"}},
+		{`/(?<area>\d+)-(?<number>\d+)/.match("03-1234").to_h["number"]`, "1234"},
+		{`/(a)(x)?/.match("a").length`, 3},
+		{`/^abc/.match("abcabc").to_s`, `#<MatchData 0:"abc">`},
+		{`/^bc/.match("abc")`, nil},
+		{`/bc$/.match("abc").to_s`, `#<MatchData 0:"bc">`},
+		{`/ab$/.match("abc")`, nil},
+		{`/\bgo\b/.match("let's go!").to_s`, `#<MatchData 0:"go">`},
+		{`/\bgo\b/.match("goby")`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRegexpMatchOperator(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`/zz/ =~ "pizza"`, 2},
+		{`/^zz/ =~ "pizza"`, nil},
+		{`/a$/ =~ "pizza"`, 4},
+		{`/😏/ =~ "🤡 😏 😐"`, 2},
+		{`"pizza" =~ /zz/`, 2},
+		{`"pizza" =~ /^p/`, 0},
+		{`"pizza" =~ /^z/`, nil},
+		// `^` and `$` match at line boundaries
+		{`"x\nab\ny" =~ /^ab$/`, 2},
+		{`Regexp.new("^b") =~ "a\nb"`, 2},
+		{`"a\nb".gsub(/^/, "> ")`, "> a\n> b"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestRegexpMatchOperatorFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`/a/.match`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`/a/.match("a", "b")`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`/a/.match(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`/a/ =~ 1`, "TypeError: Expect argument to be String. got: Integer", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
 
 func TestRegexpDupMethod(t *testing.T) {
 	tests := []struct {
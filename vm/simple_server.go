@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"context"
 	"io"
 	"io/ioutil"
 	"log"
@@ -8,6 +9,8 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
+	"sync"
 	"unicode"
 
 	"fmt"
@@ -25,7 +28,6 @@ type request struct {
 	Path             string
 	Host             string
 	Protocol         string
-	Headers          map[string][]string
 	ContentLength    int64
 	TransferEncoding []string
 }
@@ -36,95 +38,165 @@ type response struct {
 	contentType string
 }
 
-// Instance methods -----------------------------------------------------
-func builtinSimpleServerInstanceMethods() []*BuiltinMethodObject {
-	router := mux.NewRouter()
+// simpleServerState holds the router and the running Go server of a `Net::SimpleServer` object
+type simpleServerState struct {
+	router  *mux.Router
+	server  *http.Server
+	stopped chan struct{}
+	lock    sync.Mutex
+}
 
-	return []*BuiltinMethodObject{
-		{
-			Name: "mount",
-			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-				path := args[0].(*StringObject).value
-				method := args[1].(*StringObject).value
+// Instance methods -----------------------------------------------------
+var builtinSimpleServerInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Mounts the block as the handler of the path and the HTTP method. `get`, `post`, `put`, `delete` and `head` are built on it.
+		// The segments of the path which start with `:` are path parameters, which are available in `req.params`.
+		// The block takes the request and the response; if the block returns a String, it's used as the response body,
+		// and if it returns a `Net::HTTP::Response`, it's used as the response.
+		//
+		// ```ruby
+		// server = Net::SimpleServer.new(8080)
+		// server.get("/users/:id") do |req, res|
+		//   "user " + req.params["id"]
+		// end
+		// ```
+		//
+		// @param path [String], method [String], block literal
+		// @return [Net::SimpleServer]
+		Name: "mount",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			path := args[0].(*StringObject).value
+			method := args[1].(*StringObject).value
+
+			serverState(t, receiver).router.HandleFunc(muxPath(path), newHandler(t, blockFrame)).Methods(method)
+
+			return receiver
 
-				router.HandleFunc(path, newHandler(t, blockFrame)).Methods(method)
+		},
+	},
+	{
+		Name: "static",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			prefix := args[0].(*StringObject).value
+			fileName := args[1].(*StringObject).value
+			serverState(t, receiver).router.PathPrefix(prefix).Handler(http.StripPrefix(prefix, http.FileServer(http.Dir(fileName))))
 
-				return receiver
+			return receiver
 
-			},
 		},
-		{
-			Name: "static",
-			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-				prefix := args[0].(*StringObject).value
-				fileName := args[1].(*StringObject).value
-				router.PathPrefix(prefix).Handler(http.StripPrefix(prefix, http.FileServer(http.Dir(fileName))))
+	},
+	{
+		// Starts the server, and blocks until the server is stopped by `stop`.
+		//
+		// @return [Net::SimpleServer]
+		Name: "start",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			var port string
+			var serveStatic bool
+			var handler http.Handler
+			server := receiver.(*RObject)
+			state := serverState(t, server)
+
+			portVar, ok := server.InstanceVariableGet("@port")
+
+			if !ok {
+				port = "8080"
+			} else {
+				switch p := portVar.(type) {
+				case *StringObject:
+					port = p.value
+				case *IntegerObject:
+					port = strconv.Itoa(p.value)
+				default:
+					fmt.Printf("Unexpected type %s for port setting\n", portVar.Class().Name)
+				}
+			}
 
-				return receiver
+			log.Println("SimpleServer start listening on port: " + port)
 
-			},
-		},
-		{
-			Name: "start",
-			Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-				var port string
-				var serveStatic bool
-				server := receiver.(*RObject)
-
-				portVar, ok := server.InstanceVariableGet("@port")
-
-				if !ok {
-					port = "8080"
-				} else {
-					switch p := portVar.(type) {
-					case *StringObject:
-						port = p.value
-					case *IntegerObject:
-						port = strconv.Itoa(p.value)
-					default:
-						fmt.Printf("Unexpected type %s for port setting\n", portVar.Class().Name)
-					}
+			c := make(chan os.Signal, 1)
+			signal.Notify(c, os.Interrupt)
+
+			go func() {
+				for range c {
+					log.Println("SimpleServer gracefully stopped")
+					os.Exit(0)
 				}
+			}()
 
-				log.Println("SimpleServer start listening on port: " + port)
+			fileRoot, serveStatic := server.InstanceVariables.get("@file_root")
 
-				c := make(chan os.Signal, 1)
-				signal.Notify(c, os.Interrupt)
+			state.router.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				log.Printf("%s %s %s %d\n", r.Method, r.URL.Path, r.Proto, 404)
+			})
 
-				go func() {
-					for range c {
-						log.Println("SimpleServer gracefully stopped")
-						os.Exit(0)
-					}
-				}()
+			if serveStatic && fileRoot.Class() != t.vm.objectClass.getClassConstant(classes.NullClass) {
+				fr := fileRoot.(*StringObject).value
+				currentDir, _ := os.Getwd()
+				fp := filepath.Join(currentDir, fr)
+				handler = http.FileServer(http.Dir(fp))
+			} else {
+				handler = state.router
+			}
 
-				fileRoot, serveStatic := server.InstanceVariables.get("@file_root")
+			state.lock.Lock()
+			state.server = &http.Server{Addr: ":" + port, Handler: handler}
+			state.stopped = make(chan struct{})
+			httpServer, stopped := state.server, state.stopped
+			state.lock.Unlock()
 
-				router.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-					log.Printf("%s %s %s %d\n", r.Method, r.URL.Path, r.Proto, 404)
-				})
+			err := httpServer.ListenAndServe()
 
-				if serveStatic && fileRoot.Class() != t.vm.objectClass.getClassConstant(classes.NullClass) {
-					fr := fileRoot.(*StringObject).value
-					currentDir, _ := os.Getwd()
-					fp := filepath.Join(currentDir, fr)
-					fs := http.FileServer(http.Dir(fp))
-					http.Handle("/", fs)
-				} else {
-					http.Handle("/", router)
-				}
+			if err != http.ErrServerClosed { // HL
+				log.Fatalf("listen: %s\n", err)
+			}
 
-				err := http.ListenAndServe(":"+port, nil)
+			// Wait for the requests in progress to finish
+			<-stopped
 
-				if err != http.ErrServerClosed { // HL
-					log.Fatalf("listen: %s\n", err)
-				}
+			return receiver
 
+		},
+	},
+	{
+		// Stops the server gracefully: it stops accepting new requests, and `start` returns after the requests in progress finish.
+		// It can be called in a handler, and does nothing if the server isn't running.
+		//
+		// ```ruby
+		// server = Net::SimpleServer.new(8080)
+		// server.get("/shutdown") do |req, res|
+		//   server.stop
+		//   "Bye"
+		// end
+		// server.start
+		// ```
+		//
+		// @return [Net::SimpleServer]
+		Name: "stop",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			state := serverState(t, receiver)
+
+			state.lock.Lock()
+			defer state.lock.Unlock()
+
+			if state.server == nil {
 				return receiver
+			}
+
+			httpServer, stopped := state.server, state.stopped
+			state.server = nil
+
+			// Shutdown waits for the requests in progress, including the one calling `stop`, so it can't block here
+			go func() {
+				httpServer.Shutdown(context.Background())
+				close(stopped)
+			}()
+
+			return receiver
 
-			},
 		},
-	}
+	},
 }
 
 // Internal functions ===================================================
@@ -135,7 +207,7 @@ func initSimpleServerClass(vm *VM) {
 	initHTTPClass(vm)
 	net := vm.loadConstant("Net", true)
 	simpleServer := vm.initializeClass("SimpleServer")
-	simpleServer.setBuiltinMethods(builtinSimpleServerInstanceMethods(), false)
+	simpleServer.setBuiltinMethods(builtinSimpleServerInstanceMethods, false)
 	net.setClassConstant(simpleServer)
 
 	vm.mainThread.execGobyLib("net/simple_server.gb")
@@ -152,9 +224,16 @@ func newHandler(t *Thread, blockFrame *normalCallFrame) func(http.ResponseWriter
 		req := initRequest(t, w, r)
 		result := thread.builtinMethodYield(blockFrame, req, res)
 
-		if err, ok := result.Target.(*Error); ok {
-			log.Printf("Error: %s", err.message)
+		switch v := result.Target.(type) {
+		case *Error:
+			log.Printf("Error: %s", v.message)
 			res.InstanceVariableSet("@status", t.vm.InitIntegerObject(500))
+		case *StringObject:
+			res.InstanceVariableSet("@body", v)
+		case *RObject:
+			if v.Class() == httpResponseClass {
+				res = v
+			}
 		}
 
 		setupResponse(w, r, res)
@@ -174,7 +253,6 @@ func initRequest(t *Thread, w http.ResponseWriter, req *http.Request) *RObject {
 
 	r.Method = req.Method
 	r.Protocol = req.Proto
-	r.Headers = req.Header
 	r.Body = string(body)
 	r.ContentLength = req.ContentLength
 	r.TransferEncoding = req.TransferEncoding
@@ -189,8 +267,15 @@ func initRequest(t *Thread, w http.ResponseWriter, req *http.Request) *RObject {
 		reqObj.InstanceVariableSet(varName, t.vm.InitObjectFromGoType(v))
 	}
 
+	reqObj.InstanceVariableSet("@headers", t.vm.initHeadersObject(req.Header))
+
 	vars := map[string]Object{}
 
+	// Path parameters take precedence over query parameters with the same name
+	for k, v := range req.URL.Query() {
+		vars[k] = t.vm.InitStringObject(v[0])
+	}
+
 	for k, v := range mux.Vars(req) {
 		vars[k] = t.vm.InitStringObject(v)
 	}
@@ -236,6 +321,33 @@ func setupResponse(w http.ResponseWriter, req *http.Request, res *RObject) {
 	log.Printf("%s %s %s %d\n", req.Method, req.URL.Path, req.Proto, r.status)
 }
 
+// serverState returns the state of the `Net::SimpleServer` object, which is created at the first call
+func serverState(t *Thread, server Object) *simpleServerState {
+	if obj, ok := server.InstanceVariableGet("@state"); ok {
+		if state, ok := obj.(*GoObject); ok {
+			return state.data.(*simpleServerState)
+		}
+	}
+
+	state := &simpleServerState{router: mux.NewRouter()}
+	server.InstanceVariableSet("@state", t.vm.initGoObject(state))
+
+	return state
+}
+
+// muxPath converts the path parameters like `/users/:id` to the form of gorilla/mux like `/users/{id}`
+func muxPath(path string) string {
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") && len(segment) > 1 {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
 func toSnakeCase(in string) string {
 	runes := []rune(in)
 	length := len(runes)
@@ -171,3 +171,139 @@ func TestServerRequestInitialization(t *testing.T) {
 	}
 
 }
+
+func TestServerRoutingDispatch(t *testing.T) {
+	serverScript := `
+	require "net/simple_server"
+
+	server = Net::SimpleServer.new(4000)
+	server.get("/hello") do |req|
+	  "hi"
+	end
+
+	server.get("/users/:id") do |req, res|
+	  "user " + req.params["id"]
+	end
+
+	server.get("/users/:user_id/posts/:id") do |req, res|
+	  req.params["user_id"] + "/" + req.params["id"]
+	end
+
+	server.get("/search") do |req, res|
+	  "q=" + req.params["q"]
+	end
+
+	server.get("/token") do |req, res|
+	  req.headers["X-Token"].join(",")
+	end
+
+	server.post("/echo") do |req, res|
+	  req.method + " " + req.path + " " + req.body
+	end
+
+	server.get("/created") do |req, res|
+	  res.body = "created"
+	  res.status = 201
+	end
+
+	server.get("/teapot") do |req, res|
+	  r = Net::HTTP::Response.new
+	  r.body = "I'm a teapot"
+	  r.status = 418
+	  r
+	end
+
+	server
+	`
+
+	tests := []struct {
+		method         string
+		path           string
+		body           string
+		expectedBody   string
+		expectedStatus int
+	}{
+		{"GET", "/hello", "", "hi", 200},
+		{"GET", "/users/42", "", "user 42", 200},
+		{"GET", "/users/42/posts/7", "", "42/7", 200},
+		{"GET", "/search?q=goby", "", "q=goby", 200},
+		{"GET", "/token", "", "secret,other", 200},
+		{"POST", "/echo", "Hello", "POST /echo Hello", 200},
+		{"GET", "/created", "", "created", 201},
+		{"GET", "/teapot", "", "I'm a teapot", 418},
+		{"POST", "/hello", "", "404 page not found\n", 404},
+		{"GET", "/unknown", "", "404 page not found\n", 404},
+	}
+
+	v := initTestVM()
+	server := v.testEval(t, serverScript, getFilename())
+	router := serverState(&v.mainThread, server).router
+
+	for i, tt := range tests {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(tt.method, "http://localhost:4000"+tt.path, strings.NewReader(tt.body))
+		req.Header.Add("X-Token", "secret")
+		req.Header.Add("X-Token", "other")
+
+		router.ServeHTTP(recorder, req)
+
+		if recorder.Body.String() != tt.expectedBody {
+			t.Errorf("At test case %d: Expect response body to be %q. got=%q", i, tt.expectedBody, recorder.Body.String())
+		}
+
+		if recorder.Code != tt.expectedStatus {
+			t.Errorf("At test case %d: Expect response status to be %d. got=%d", i, tt.expectedStatus, recorder.Code)
+		}
+	}
+}
+
+func TestServerStopMethod(t *testing.T) {
+	serverScript := `
+	require "net/simple_server"
+
+	server = Net::SimpleServer.new(4002)
+	server.get("/stop") do |req, res|
+	  server.stop
+	  "stopping"
+	end
+
+	server.start
+	"stopped"
+	`
+
+	v := initTestVM()
+	result := make(chan Object, 1)
+
+	go func() {
+		result <- v.testEval(t, serverScript, getFilename())
+	}()
+
+	var resp *http.Response
+	var err error
+
+	for i := 0; i < 50; i++ {
+		resp, err = http.Get("http://localhost:4002/stop")
+		if err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if string(body) != "stopping" {
+		t.Fatalf("Expect response body to be \"stopping\". got=%q", string(body))
+	}
+
+	select {
+	case evaluated := <-result:
+		VerifyExpected(t, 0, evaluated, "stopped")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expect the server to be stopped")
+	}
+}
@@ -117,6 +117,68 @@ func TestBreakStatement(t *testing.T) {
 		a = i * 10
 		a + 100
 				`, 1150},
+		{`
+		[1, 2, 3].each do |i|
+		  if i == 2
+		    break
+		  end
+		end
+		`, nil},
+		{`
+		[1, 2, 3, 4].each do |i|
+		  if i == 3
+		    break i * 10
+		  end
+		end
+		`, 30},
+		{`
+		r = [1, 2, 3].map do |i|
+		  break "stopped"
+		end
+
+		r
+		`, "stopped"},
+		{`
+		[1, 2, 3].each do |i|
+		  break i end
+		`, 1},
+		{`
+		def foo
+		  yield(1)
+		  yield(2)
+		  "done"
+		end
+
+		foo do |i|
+		  break i + 100
+		end
+		`, 101},
+		{`
+		def foo
+		  i = 0
+		  while true do
+		    i += 1
+		    if i == 3
+		      break i * 10
+		    end
+		  end
+		end
+
+		foo
+		`, 30},
+		{`
+		def foo
+		  i = 0
+		  while i < 3 do
+		    [1, 2].each do |j|
+		      break j
+		    end
+		    i += 1
+		  end
+		end
+
+		foo
+		`, nil},
 	}
 
 	for i, tt := range tests {
@@ -176,6 +238,56 @@ func TestDefStatement(t *testing.T) {
 		foo(10, 20)
 		`, 42},
 		{`
+		def foo(a, b = a * 2)
+		  a + b
+		end
+
+		foo(1)
+		`, 3},
+		{`
+		def foo(a, b = a * 2)
+		  a + b
+		end
+
+		foo(1, 5)
+		`, 6},
+		{`
+		def foo(a, b = a + 1, c = b * 10)
+		  a + b + c
+		end
+
+		foo(1)
+		`, 23},
+		{`
+		class Foo
+		  def initialize
+		    @x = 3
+		  end
+
+		  def bar(a = @x)
+		    a
+		  end
+		end
+
+		Foo.new.bar
+		`, 3},
+		{`
+		Ten = 10
+
+		def foo(a = Ten)
+		  a
+		end
+
+		foo
+		`, 10},
+		{`
+		def greet(name, greeting = "Hello")
+		  greeting + ", " + name
+		end
+
+		greet("Goby") + " " + greet("Goby", "Hi")
+		`, "Hello, Goby Hi, Goby"},
+		{`
 		class Foo; end
 
 		def Foo.foo
@@ -267,6 +379,78 @@ func TestDefStatementWithSplatArgument(t *testing.T) {
 
 		foo(10, 20, 30)
 		`, 60},
+		{`
+		def foo(*args)
+		  args
+		end
+
+		foo(1, 2, 3)
+		`, []interface{}{1, 2, 3}},
+		{`
+		def foo(a, *rest)
+		  rest
+		end
+
+		foo(1)
+		`, []interface{}{}},
+		{`
+		def foo(a, *rest)
+		  [a, rest.length]
+		end
+
+		foo(1, 2, 3)
+		`, []interface{}{1, 2}},
+		{`
+		def foo(*args)
+		  args
+		end
+
+		foo(*[1, 2, 3])
+		`, []interface{}{1, 2, 3}},
+		{`
+		def foo(a, *rest)
+		  rest
+		end
+
+		foo(*[1, 2, 3])
+		`, []interface{}{2, 3}},
+		{`
+		def foo(a, *rest)
+		  rest
+		end
+
+		foo(0, *[1, 2], 3)
+		`, []interface{}{1, 2, 3}},
+		{`
+		def foo(a, b = 1)
+		  [a, b]
+		end
+
+		foo(*[5, 6])
+		`, []interface{}{5, 6}},
+		{`
+		def foo(a, b = 1, *c)
+		  [a, b, c.length]
+		end
+
+		foo(*[5, 6, 7, 8])
+		`, []interface{}{5, 6, 2}},
+		{`
+		def foo(a, k: 3)
+		  [a, k]
+		end
+
+		foo(*[5], k: 4)
+		`, []interface{}{5, 4}},
+		{`
+		def foo(*a)
+		  a.length
+		end
+
+		a = [1, 2]
+		foo(*a)
+		foo(a)
+		`, 1},
 	}
 
 	for i, tt := range tests {
@@ -375,6 +559,27 @@ func TestDefStatementWithKeywordArgument(t *testing.T) {
 
 		foo(b: 20, a: 10, 40)
 		`, 50},
+		{`
+		def foo(bar, foo = 100, a:, b: 20)
+		  [bar, foo, a, b]
+		end
+
+		foo(1, 2, a: 3)
+		`, []interface{}{1, 2, 3, 20}},
+		{`
+		def connect(host:, port: 8080)
+		  host + ":" + port.to_s
+		end
+
+		connect(host: "x", port: 9090)
+		`, "x:9090"},
+		{`
+		def connect(host:, port: 8080)
+		  host + ":" + port.to_s
+		end
+
+		connect(host: "x")
+		`, "x:8080"},
 
 		// Add splat arguments
 		{`
@@ -585,6 +790,48 @@ end
 
 i
 		`, 12},
+		{`
+		[1, 2, 3, 4].map do |i|
+		  if i == 2
+		    next
+		  end
+		  i * 10
+		end.to_s
+		`, "[10, nil, 30, 40]"},
+		{`
+		[1, 2, 3, 4].map do |i|
+		  if i.even?
+		    next 0
+		  end
+		  i
+		end.to_s
+		`, "[1, 0, 3, 0]"},
+		{`
+		sum = 0
+		[1, 2, 3, 4].each do |i|
+		  if i == 3
+		    next
+		  end
+		  sum += i
+		end
+
+		sum
+		`, 7},
+		{`
+		sum = 0
+		i = 0
+		while i < 3 do
+		  i += 1
+		  [1, 2, 3].each do |j|
+		    if j == 2
+		      next
+		    end
+		    sum += j
+		  end
+		end
+
+		sum
+		`, 12},
 	}
 
 	for i, tt := range tests {
@@ -636,6 +883,92 @@ func TestReturnStatement(t *testing.T) {
 	}
 }
 
+func TestUntilStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{`
+		i = 10
+		until i == 0 do
+		  i -= 1
+		end
+
+		i
+		`, 0},
+		{`
+		i = 10
+		until i > 5
+		  i -= 1
+		end
+
+		i
+		`, 10},
+		{`
+		a = [1, 2, 3]
+		sum = 0
+		until a.empty?
+		  sum += a.pop
+		end
+
+		sum
+		`, 6},
+		{`
+		i = 0
+		i += 3 until i > 10
+		i
+		`, 12},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestWhileStatementReturnValue(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		def foo
+		  i = 0
+		  while i < 3
+		    i += 1
+		  end
+		end
+
+		foo
+		`, nil},
+		{`
+		def foo
+		  i = 0
+		  i += 1 until i == 3
+		end
+
+		foo
+		`, nil},
+		{`
+		i = 0
+		while i < 3
+		  i += 1
+		end
+		`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestWhileStatement(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -710,6 +1043,38 @@ func TestWhileStatement(t *testing.T) {
 
 		i
 		`, 0},
+		{`
+		i = 0
+		while i < 5
+		  i += 1
+		end
+
+		i
+		`, 5},
+		{`
+		i = 0
+		while i < 5; i += 1; end
+		i
+		`, 5},
+		{`
+		i = 0
+		while i < 3
+		  j = i * 10
+		  i += 1
+		end
+
+		j
+		`, 20},
+		{`
+		i = 0
+		i += 1 while i < 10
+		i
+		`, 10},
+		{`
+		i = 10
+		i += 1 while i < 5
+		i
+		`, 10},
 	}
 
 	for i, tt := range tests {
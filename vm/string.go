@@ -1,12 +1,15 @@
 package vm
 
 import (
+	"bytes"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/dlclark/regexp2"
 	"github.com/goby-lang/goby/vm/classes"
 	"github.com/goby-lang/goby/vm/errors"
 )
@@ -89,6 +92,33 @@ var builtinStringClassMethods = []*BuiltinMethodObject{
 
 // Instance methods -----------------------------------------------------
 var builtinStringInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the string formatted with the argument as a printf-style format string.
+		// Pass an array to format with multiple arguments. See `sprintf` for the supported formats.
+		//
+		// ```ruby
+		// "%.2f" % 3.14159            # => "3.14"
+		// "%s is %d" % ["Goby", 5]    # => "Goby is 5"
+		// ```
+		//
+		// @param argument [Object]
+		// @return [String]
+		Name: "%",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			formatArgs := args
+
+			if arr, ok := args[0].(*ArrayObject); ok {
+				formatArgs = arr.Elements
+			}
+
+			return receiver.(*StringObject).format(t, formatArgs, sourceLine)
+
+		},
+	},
 	{
 		// Returns the concatenation of self and another String.
 		//
@@ -113,13 +143,16 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns self multiplying another Integer.
+		// Returns a new string containing the given number of copies of self.
+		// Raises an error if the count is negative.
 		//
 		// ```ruby
-		// "string " * 2 # => "string string string "
+		// "string " * 2 # => "string string "
+		// "ab" * 3      # => "ababab"
+		// "x" * 0       # => ""
 		// ```
 		//
-		// #param positive integer [Integer]
+		// @param count [Integer]
 		// @return [String]
 		Name: "*",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
@@ -133,14 +166,8 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.NegativeSecondValue, right.value)
 			}
 
-			var result string
-
 			left := receiver.(*StringObject)
-			for i := 0; i < right.value; i++ {
-				result += left.value
-			}
-
-			return t.vm.InitStringObject(result)
+			return t.vm.InitStringObject(strings.Repeat(left.value, right.value))
 
 		},
 	},
@@ -261,12 +288,14 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 	{
 		// Returns a Integer.
 		// Returns -1 if the first string is less than the second string returns -1, returns 0 if equal to, or returns 1 if greater than.
+		// Returns nil if the Object is not a String.
 		//
 		//
 		// ```ruby
 		// "abc" <=> "abcd" # => -1
 		// "abc" <=> "abc" # => 0
 		// "abcd" <=> "abc" # => 1
+		// "abc" <=> 1 # => nil
 		// ```
 		//
 		// @param string [String]
@@ -277,7 +306,7 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 			right, ok := args[0].(*StringObject)
 
 			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+				return NULL
 			}
 
 			left := receiver.(*StringObject)
@@ -362,31 +391,7 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 				return NULL
 			case *RangeObject:
-				strLength := utf8.RuneCountInString(str)
-				start := index.Start
-				end := index.End
-
-				if start < 0 {
-					start = strLength + start
-
-					if start < 0 {
-						return NULL
-					}
-				}
-
-				if end < 0 {
-					end = strLength + end
-				}
-
-				if start > strLength {
-					return NULL
-				}
-
-				if end >= strLength {
-					end = strLength - 1
-				}
-
-				return t.vm.InitStringObject(string([]rune(str)[start : end+1]))
+				return receiver.(*StringObject).sliceByRange(t, index)
 			default:
 				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, i.Class().Name)
 			}
@@ -456,6 +461,33 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns an array of the bytes of the string as integers.
+		// Passing an empty string returns an empty array.
+		//
+		// ```ruby
+		// "Goby".bytes # => [71, 111, 98, 121]
+		// "😊".bytes   # => [240, 159, 152, 138]
+		// "".bytes     # => []
+		// ```
+		//
+		// @return [Array]
+		Name: "bytes",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			str := receiver.(*StringObject).value
+			elements := make([]Object, len(str))
+			for i, b := range []byte(str) {
+				elements[i] = t.vm.InitIntegerObject(int(b))
+			}
+
+			return t.vm.InitArrayObject(elements)
+
+		},
+	},
 	{
 		// Returns a new String with the first character converted to uppercase.
 		// Non case-sensitive characters will be remained untouched.
@@ -472,11 +504,82 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 
 			str := receiver.(*StringObject).value
-			start := string([]rune(str)[0])
-			rest := string([]rune(str)[1:])
-			result := strings.ToUpper(start) + strings.ToLower(rest)
 
-			return t.vm.InitStringObject(result)
+			return t.vm.InitStringObject(capitalize(str))
+
+		},
+	},
+	{
+		// A destructive method.
+		// Converts the first character of self to uppercase and the rest to lowercase.
+		// Returns self, or `nil` if no changes were made.
+		//
+		// ```ruby
+		// a = "hello World"
+		// a.capitalize! # => "Hello world"
+		// a             # => "Hello world"
+		// a.capitalize! # => nil
+		// ```
+		//
+		// @return [String]
+		Name: "capitalize!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			str := receiver.(*StringObject)
+
+			return str.setValue(capitalize(str.value))
+
+		},
+	},
+	{
+		// Add padding strings to both sides of the string to center it with the specified length.
+		// If the padding is omitted, one space character " " will be the default padding.
+		// When the padding can't be split evenly, the right side gets the extra character.
+		//
+		// If the specified length is equal to or shorter than the current length, no padding will be performed, and the receiver will be returned.
+		// If the padding is performed, a new padded string will be returned.
+		//
+		// Raises an error if the input string length is not integer type, or the padding is an empty string.
+		//
+		// ```ruby
+		// "Hello".center(2)          # => "Hello"
+		// "Hello".center(8)          # => " Hello  "
+		// "Hello".center(11, "xo")   # => "xoxHelloxox"
+		// ```
+		//
+		// @param length [Integer], padding [String]
+		// @return [String]
+		Name: "center",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*StringObject).justify(t, args, sourceLine, func(padding int) int {
+				return padding / 2
+			})
+
+		},
+	},
+	{
+		// Returns an array of the characters of the string; same as `to_a`.
+		// Passing an empty string returns an empty array.
+		//
+		// ```ruby
+		// "abc".chars       # => ["a", "b", "c"]
+		// "😊Hello🐟".chars # => ["😊", "H", "e", "l", "l", "o", "🐟"]
+		// "".chars          # => []
+		// ```
+		//
+		// @return [Array]
+		Name: "chars",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			str := receiver.(*StringObject)
+			return t.vm.InitArrayObject(str.chars(t))
 
 		},
 	},
@@ -595,6 +698,32 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// A destructive method.
+		// Converts all characters of self to lowercase.
+		// Returns self, or `nil` if no changes were made.
+		//
+		// ```ruby
+		// a = "erROR"
+		// a.downcase! # => "error"
+		// a           # => "error"
+		// a.downcase! # => nil
+		// ```
+		//
+		// @return [String]
+		Name: "downcase!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			str := receiver.(*StringObject)
+
+			return str.setValue(strings.ToLower(str.value))
+
+		},
+	},
 	{
 		Name: "dup",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
@@ -736,6 +865,9 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// @return [Boolean]
 		Name: "empty?",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
 
 			str := receiver.(*StringObject).value
 
@@ -816,6 +948,30 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns a copy of str with the all occurrences of pattern substituted for the second argument;
+		// same as `replace`. The pattern is typically a String or Regexp.
+		// If a block is given instead of the second argument, each match is passed to the block
+		// and substituted for the result of the block.
+		//
+		// ```ruby
+		// "a-b-c".gsub("-", "_") # => "a_b_c"
+		//
+		// "a-b-c".gsub("-") do |m|
+		//   m + m
+		// end
+		// # => "a--b--c"
+		// ```
+		//
+		// @param pattern [Regexp/String], ([String] the new string)
+		// @return [String]
+		Name: "gsub",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			str := receiver.(*StringObject)
+			return str.replace(t, args, blockFrame, sourceLine, -1)
+
+		},
+	},
 	{
 		// Checks if the specified string is included in the receiver.
 		//
@@ -846,6 +1002,28 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns the index of the first occurrence of the given substring, or `nil` if not found.
+		// The index counts characters (UTF-8 runes) rather than bytes.
+		// If an offset is given, the search starts from the offset; a negative offset counts from the end.
+		//
+		// ```ruby
+		// "abcabc".index("bc")    # => 1
+		// "abcabc".index("bc", 2) # => 4
+		// "abcabc".index("x")     # => nil
+		// "abcabc".index("")      # => 0
+		// "😊abc".index("bc")     # => 2
+		// ```
+		//
+		// @param substring [String], (offset [Integer])
+		// @return [Integer]
+		Name: "index",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			str := receiver.(*StringObject)
+			return str.index(t, args, sourceLine, false)
+
+		},
+	},
 	{
 		// Insert a string input in specified index value of the receiver string.
 		//
@@ -930,7 +1108,7 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// If the specified length is equal to or shorter than the current length, no padding will be performed, and the receiver will be returned.
 		// If the padding is performed, a new padded string will be returned.
 		//
-		// Raises an error if the input string length is not integer type.
+		// Raises an error if the input string length is not integer type, or the padding is an empty string.
 		//
 		// ```ruby
 		// "Hello".ljust(2)           # => "Hello"
@@ -942,46 +1120,53 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// @return [String]
 		Name: "ljust",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			aLen := len(args)
-			if aLen < 1 || aLen > 2 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
-			}
-
-			strLength, ok := args[0].(*IntegerObject)
+			return receiver.(*StringObject).justify(t, args, sourceLine, func(padding int) int {
+				return 0
+			})
 
-			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.IntegerClass, args[0].Class().Name)
-			}
+		},
+	},
+	{
+		// Returns a copy of str with leading whitespace removed.
+		// Whitespace is defined as the same characters as `strip`.
+		//
+		// ```ruby
+		// "  Goby Lang  ".lstrip # => "Goby Lang  "
+		// ```
+		//
+		// @return [String]
+		Name: "lstrip",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 
-			strLengthValue := strLength.value
+			str := receiver.(*StringObject).value
 
-			var padStrValue string
-			if aLen == 1 {
-				padStrValue = " "
-			} else {
-				p := args[1]
-				padStr, ok := p.(*StringObject)
+			return t.vm.InitStringObject(strings.TrimLeft(str, whitespaceChars))
 
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, classes.StringClass, p.Class().Name)
-				}
+		},
+	},
+	{
+		// A destructive method.
+		// Removes leading whitespace from self.
+		// Returns self, or `nil` if no changes were made.
+		//
+		// ```ruby
+		// a = "  Goby Lang\n"
+		// a.lstrip! # => "Goby Lang\n"
+		// a         # => "Goby Lang\n"
+		// a.lstrip! # => nil
+		// ```
+		//
+		// @return [String]
+		Name: "lstrip!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 
-				padStrValue = padStr.value
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
 			}
 
-			str := receiver.(*StringObject).value
-			currentStrLength := utf8.RuneCountInString(str)
-			padStrLength := utf8.RuneCountInString(padStrValue)
-
-			if strLengthValue > currentStrLength {
-				for i := currentStrLength; i < strLengthValue; i += padStrLength {
-					str += padStrValue
-				}
-				str = string([]rune(str)[:strLengthValue])
-			}
+			str := receiver.(*StringObject)
 
-			// Support UTF-8 Encoding
-			return t.vm.InitStringObject(str)
+			return str.setValue(strings.TrimLeft(str.value, whitespaceChars))
 
 		},
 	},
@@ -1027,6 +1212,8 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// regular expression metacharacters it contains will be interpreted literally, e.g. '\\d' will
 		// match a backslash followed by ‘d’, instead of a digit.
 		//
+		// If a block is given instead of the second argument, each match is substituted for the result of the block.
+		//
 		// `#replace` is equivalent to Ruby's `gsub`.
 		// ```ruby
 		// "Ruby Lang".replace("Ru", "Go")                # => "Goby Lang"
@@ -1040,32 +1227,8 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// @return [String]
 		Name: "replace",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 2 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 2, len(args))
-			}
-
-			r := args[1]
-			replacement, ok := r.(*StringObject)
-			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, classes.StringClass, args[1].Class().Name)
-			}
-
-			var result string
-			var err error
-			target := receiver.(*StringObject).value
-			switch pattern := args[0].(type) {
-			case *StringObject:
-				result = strings.Replace(target, pattern.value, replacement.value, -1)
-			case *RegexpObject:
-				result, err = pattern.regexp.Replace(target, replacement.value, 0, -1)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.RegexpFailure, args[0].Class().Name)
-				}
-			default:
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.StringClass+" or "+classes.RegexpClass, args[0].Class().Name)
-			}
-
-			return t.vm.InitStringObject(result)
+			str := receiver.(*StringObject)
+			return str.replace(t, args, blockFrame, sourceLine, -1)
 
 		},
 	},
@@ -1082,36 +1245,16 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// "Ruby Lang ruby lang".replace_once(re, "Go")                # => "Goby Lang ruby lang"
 		// ```
 		//
+		// If a block is given instead of the second argument, the match is substituted for the result of the block.
+		//
+		// `#replace_once` is equivalent to Ruby's `sub`.
+		//
 		// @param pattern [Regexp/String], [String] the new string
 		// @return [String]
 		Name: "replace_once",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 2 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 2, len(args))
-			}
-
-			r := args[1]
-			replacement, ok := r.(*StringObject)
-			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, classes.StringClass, args[1].Class().Name)
-			}
-
-			var result string
-			var err error
-			target := receiver.(*StringObject).value
-			switch pattern := args[0].(type) {
-			case *StringObject:
-				result = strings.Replace(target, pattern.value, replacement.value, 1)
-			case *RegexpObject:
-				result, err = pattern.regexp.Replace(target, replacement.value, 0, 1)
-				if err != nil {
-					return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.RegexpFailure, args[0].Class().Name)
-				}
-			default:
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.StringClass+" or "+classes.RegexpClass, args[0].Class().Name)
-			}
-
-			return t.vm.InitStringObject(result)
+			str := receiver.(*StringObject)
+			return str.replace(t, args, blockFrame, sourceLine, 1)
 
 		},
 	},
@@ -1140,6 +1283,28 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns the index of the last occurrence of the given substring, or `nil` if not found.
+		// The index counts characters (UTF-8 runes) rather than bytes.
+		// If an offset is given, the search ends at the offset; a negative offset counts from the end.
+		//
+		// ```ruby
+		// "abcabc".rindex("bc")    # => 4
+		// "abcabc".rindex("bc", 3) # => 1
+		// "abcabc".rindex("x")     # => nil
+		// "abcabc".rindex("")      # => 6
+		// "😊abc😊".rindex("😊")   # => 4
+		// ```
+		//
+		// @param substring [String], (offset [Integer])
+		// @return [Integer]
+		Name: "rindex",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			str := receiver.(*StringObject)
+			return str.index(t, args, sourceLine, true)
+
+		},
+	},
 	{
 		// Add padding strings to the left side of the string to be "right-justification" with the specified length.
 		// If the padding is omitted, one space character " " will be the default padding.
@@ -1147,7 +1312,7 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// If the specified length is equal to or shorter than the current length, no padding will be performed, and the receiver will be returned.
 		// If the padding is performed, a new padded string will be returned.
 		//
-		// Raises an error if the input string length is not integer type.
+		// Raises an error if the input string length is not integer type, or the padding is an empty string.
 		//
 		// ```ruby
 		// "Hello".rjust(2)          # => "Hello"
@@ -1160,50 +1325,128 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// @return [String]
 		Name: "rjust",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			aLen := len(args)
-			if aLen < 1 || aLen > 2 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
-			}
+			return receiver.(*StringObject).justify(t, args, sourceLine, func(padding int) int {
+				return padding
+			})
 
-			strLength, ok := args[0].(*IntegerObject)
-			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.IntegerClass, args[0].Class().Name)
-			}
+		},
+	},
+	{
+		// Returns a copy of str with trailing whitespace removed.
+		// Whitespace is defined as the same characters as `strip`.
+		//
+		// ```ruby
+		// "  Goby Lang  ".rstrip # => "  Goby Lang"
+		// ```
+		//
+		// @return [String]
+		Name: "rstrip",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 
-			strLengthValue := strLength.value
+			str := receiver.(*StringObject).value
 
-			var padStrValue string
-			if aLen == 1 {
-				padStrValue = " "
-			} else {
-				p := args[1]
-				padStr, ok := p.(*StringObject)
+			return t.vm.InitStringObject(strings.TrimRight(str, whitespaceChars))
 
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, classes.StringClass, args[1].Class().Name)
-				}
+		},
+	},
+	{
+		// A destructive method.
+		// Removes trailing whitespace from self.
+		// Returns self, or `nil` if no changes were made.
+		//
+		// ```ruby
+		// a = "  Goby Lang\n"
+		// a.rstrip! # => "  Goby Lang"
+		// a         # => "  Goby Lang"
+		// a.rstrip! # => nil
+		// ```
+		//
+		// @return [String]
+		Name: "rstrip!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
 
-				padStrValue = padStr.value
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
 			}
 
-			padStrLength := utf8.RuneCountInString(padStrValue)
+			str := receiver.(*StringObject)
+
+			return str.setValue(strings.TrimRight(str.value, whitespaceChars))
+
+		},
+	},
+	{
+		// Returns an array of all the non-overlapping matches of the pattern in self.
+		// If the pattern is a Regexp with capture groups, each element is an array of the captured strings,
+		// where `nil` stands for a group that did not participate in the match.
+		//
+		// ```ruby
+		// "a1b2c3".scan("1")                             # => ["1"]
+		// "abcabc".scan("bc")                            # => ["bc", "bc"]
+		// "abc".scan("z")                                # => []
+		// "a1b2c3".scan(Regexp.new("[0-9]"))             # => ["1", "2", "3"]
+		// "a1b2c3".scan(Regexp.new("([a-z])([0-9])"))    # => [["a", "1"], ["b", "2"], ["c", "3"]]
+		// ```
+		//
+		// @param pattern [String/Regexp]
+		// @return [Array]
+		Name: "scan",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
 
 			str := receiver.(*StringObject).value
-			if strLengthValue > len(str) {
-				origin := str
-				originStrLength := utf8.RuneCountInString(origin)
-				for i := originStrLength; i < strLengthValue; i += padStrLength {
-					str = padStrValue + str
+			var elems []Object
+
+			switch pattern := args[0].(type) {
+			case *StringObject:
+				for i := 0; i <= len(str); {
+					index := strings.Index(str[i:], pattern.value)
+					if index < 0 {
+						break
+					}
+
+					elems = append(elems, t.vm.InitStringObject(pattern.value))
+					i += index + len(pattern.value)
+
+					// An empty pattern matches between every character
+					if pattern.value == "" {
+						if i == len(str) {
+							break
+						}
+						_, size := utf8.DecodeRuneInString(str[i:])
+						i += size
+					}
 				}
-				currentStrLength := utf8.RuneCountInString(str)
-				if currentStrLength > strLengthValue {
-					chopLength := currentStrLength - strLengthValue
-					str = string([]rune(str)[:currentStrLength-originStrLength-chopLength]) + origin
+			case *RegexpObject:
+				match, err := pattern.regexp.FindStringMatch(str)
+				for ; match != nil && err == nil; match, err = pattern.regexp.FindNextMatch(match) {
+					groups := match.Groups()
+					if len(groups) == 1 {
+						elems = append(elems, t.vm.InitStringObject(match.String()))
+						continue
+					}
+
+					var captures []Object
+					for _, group := range groups[1:] {
+						if len(group.Captures) == 0 {
+							captures = append(captures, NULL)
+						} else {
+							captures = append(captures, t.vm.InitStringObject(group.String()))
+						}
+					}
+					elems = append(elems, t.vm.InitArrayObject(captures))
 				}
+
+				if err != nil {
+					return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.RegexpFailure, args[0].Class().Name)
+				}
+			default:
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass+" or "+classes.RegexpClass, args[0].Class().Name)
 			}
 
-			// Support UTF-8 Encoding
-			return t.vm.InitStringObject(str)
+			return t.vm.InitArrayObject(elems)
 
 		},
 	},
@@ -1228,7 +1471,9 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns a string sliced according to the input range.
+		// Returns a string sliced according to the input range, or the character at the given index.
+		// If a start index and a length are given, returns the string of up to the length of characters
+		// from the start index; a negative start index counts from the end.
 		//
 		// ```ruby
 		// "Hello World".slice(1..6)    # => "ello W"
@@ -1262,14 +1507,25 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// "Hello 😊🐟 World".slice(-10)     # => "o"
 		// "Hello 😊🐟 World".slice(-15)     # => nil
 		// "Hello 😊🐟 World".slice(14)      # => nil
+		// "hello".slice(1, 3)          # => "ell"
+		// "hello".slice(-3, 2)         # => "ll"
+		// "hello".slice(3, 10)         # => "lo"
+		// "hello".slice(5, 1)          # => ""
+		// "hello".slice(6, 1)          # => nil
 		// ```
 		//
-		// @param slicing point or range [Integer/Range]
+		// @param slicing point or range [Integer/Range], (length [Integer])
 		// @return [String]
 		Name: "slice",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 1 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			aLen := len(args)
+			if aLen < 1 || aLen > 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
+			}
+
+			if aLen == 2 {
+				str := receiver.(*StringObject)
+				return str.substring(t, args, sourceLine)
 			}
 
 			str := receiver.(*StringObject).value
@@ -1279,41 +1535,7 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 			slice := args[0]
 			switch slice.(type) {
 			case *RangeObject:
-				ro := slice.(*RangeObject)
-				switch {
-				case ro.Start >= 0 && ro.End >= 0:
-					if ro.Start > strLength {
-						return NULL
-					} else if ro.Start > ro.End {
-						return t.vm.InitStringObject("")
-					}
-					return t.vm.InitStringObject(string([]rune(str)[ro.Start : ro.End+1]))
-				case ro.Start < 0 && ro.End >= 0:
-					positiveStart := strLength + ro.Start
-					if -ro.Start > strLength {
-						return NULL
-					} else if positiveStart > ro.End {
-						return t.vm.InitStringObject("")
-					}
-					return t.vm.InitStringObject(string([]rune(str)[positiveStart : ro.End+1]))
-				case ro.Start >= 0 && ro.End < 0:
-					positiveEnd := strLength + ro.End
-					if ro.Start > strLength {
-						return NULL
-					} else if positiveEnd < 0 || ro.Start > positiveEnd {
-						return t.vm.InitStringObject("")
-					}
-					return t.vm.InitStringObject(string([]rune(str)[ro.Start : positiveEnd+1]))
-				default:
-					positiveStart := strLength + ro.Start
-					positiveEnd := strLength + ro.End
-					if positiveStart < 0 {
-						return NULL
-					} else if positiveStart > positiveEnd {
-						return t.vm.InitStringObject("")
-					}
-					return t.vm.InitStringObject(string([]rune(str)[positiveStart : positiveEnd+1]))
-				}
+				return receiver.(*StringObject).sliceByRange(t, slice.(*RangeObject))
 
 			case *IntegerObject:
 				iv := slice.(*IntegerObject).value
@@ -1335,37 +1557,64 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns an array of strings separated by the given delimiter.
+		// Returns an array of strings separated by the given delimiter, which can be a String or a Regexp.
+		// If no delimiter is given, the string is split on runs of whitespace,
+		// and the leading and trailing whitespace is ignored.
+		// If a positive limit is given, returns at most the limit number of strings,
+		// and the last string holds the rest of the string.
 		//
 		// ```ruby
 		// "Hello World".split("o") # => ["Hell", " W", "rld"]
 		// "Goby".split("")         # => ["G", "o", "b", "y"]
 		// "Hello\nWorld\nGoby".split("o") # => ["Hello", "World", "Goby"]
 		// "Hello🐟World🐟Goby".split("🐟") # => ["Hello", "World", "Goby"]
+		// "  Hello \t World\n".split  # => ["Hello", "World"]
+		// "a,b,c".split(",", 2)      # => ["a", "b,c"]
+		// "a1b22c".split(/[0-9]+/)   # => ["a", "b", "c"]
+		// "abc".split(//)            # => ["a", "b", "c"]
 		// ```
 		//
-		// @param delimiter [String]
+		// @param delimiter [String/Regexp], (limit [Integer])
 		// @return [Array]
 		Name: "split",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 1 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			aLen := len(args)
+			if aLen > 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 2, aLen)
 			}
 
-			separator, ok := args[0].(*StringObject)
-			if !ok {
-				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+			str := receiver.(*StringObject).value
+			if aLen == 0 {
+				return t.vm.InitArrayObject(stringsToObjects(t, strings.Fields(str)))
 			}
 
-			str := receiver.(*StringObject).value
-			arr := strings.Split(str, separator.value)
+			switch args[0].(type) {
+			case *StringObject, *RegexpObject:
+			default:
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass+" or "+classes.RegexpClass, args[0].Class().Name)
+			}
 
-			var elements []Object
-			for i := 0; i < len(arr); i++ {
-				elements = append(elements, t.vm.InitStringObject(arr[i]))
+			limit := -1
+			if aLen == 2 {
+				l, ok := args[1].(*IntegerObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[1].Class().Name)
+				}
+
+				if l.value > 0 {
+					limit = l.value
+				}
 			}
 
-			return t.vm.InitArrayObject(elements)
+			var arr []string
+			switch separator := args[0].(type) {
+			case *StringObject:
+				arr = strings.SplitN(str, separator.value, limit)
+			case *RegexpObject:
+				arr = splitByRegexp(str, separator.regexp, limit)
+			}
+
+			return t.vm.InitArrayObject(stringsToObjects(t, arr))
 
 		},
 	},
@@ -1418,6 +1667,7 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 		// ```ruby
 		// "  Goby Lang  ".strip   # => "Goby Lang"
 		// "\nGoby Lang\r\t".strip # => "Goby Lang"
+		// " \t\n ".strip         # => ""
 		// ```
 		//
 		// @return [String]
@@ -1426,20 +1676,102 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 			str := receiver.(*StringObject).value
 
-			for {
-				str = strings.Trim(str, " ")
+			return t.vm.InitStringObject(strings.Trim(str, whitespaceChars))
 
-				if strings.HasPrefix(str, "\n") || strings.HasPrefix(str, "\t") || strings.HasPrefix(str, "\r") || strings.HasPrefix(str, "\v") {
-					str = string([]rune(str)[1:])
-					continue
-				}
-				if strings.HasSuffix(str, "\n") || strings.HasSuffix(str, "\t") || strings.HasSuffix(str, "\r") || strings.HasSuffix(str, "\v") {
-					str = string([]rune(str)[:utf8.RuneCountInString(str)-2])
-					continue
-				}
-				break
+		},
+	},
+	{
+		// A destructive method.
+		// Removes leading and trailing whitespace from self.
+		// Returns self, or `nil` if no changes were made.
+		//
+		// ```ruby
+		// a = "  Goby Lang\n"
+		// a.strip! # => "Goby Lang"
+		// a        # => "Goby Lang"
+		// a.strip! # => nil
+		// ```
+		//
+		// @return [String]
+		Name: "strip!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
 			}
-			return t.vm.InitStringObject(str)
+
+			str := receiver.(*StringObject)
+
+			return str.setValue(strings.Trim(str.value, whitespaceChars))
+
+		},
+	},
+	{
+		// Returns a copy of str with the first occurrence of pattern substituted for the second argument;
+		// same as `replace_once`. The pattern is typically a String or Regexp.
+		// If a block is given instead of the second argument, the match is passed to the block
+		// and substituted for the result of the block.
+		//
+		// ```ruby
+		// "a-b-c".sub("-", "_") # => "a_b-c"
+		//
+		// "a-b-c".sub("-") do |m|
+		//   m + m
+		// end
+		// # => "a--b-c"
+		// ```
+		//
+		// @param pattern [Regexp/String], ([String] the new string)
+		// @return [String]
+		Name: "sub",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			str := receiver.(*StringObject)
+			return str.replace(t, args, blockFrame, sourceLine, 1)
+
+		},
+	},
+	{
+		// Returns a new String with the uppercase characters converted to lowercase, and vice versa.
+		// Non case-sensitive characters will be remained untouched.
+		//
+		// ```ruby
+		// "Hello World".swapcase # => "hELLO wORLD"
+		// "😊HeLlO".swapcase     # => "😊hElLo"
+		// ```
+		//
+		// @return [String]
+		Name: "swapcase",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+
+			str := receiver.(*StringObject).value
+
+			return t.vm.InitStringObject(swapcase(str))
+
+		},
+	},
+	{
+		// A destructive method.
+		// Converts the uppercase characters of self to lowercase, and vice versa.
+		// Returns self, or `nil` if no changes were made.
+		//
+		// ```ruby
+		// a = "Hello World"
+		// a.swapcase! # => "hELLO wORLD"
+		// a           # => "hELLO wORLD"
+		// "123".swapcase! # => nil
+		// ```
+		//
+		// @return [String]
+		Name: "swapcase!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			str := receiver.(*StringObject)
+
+			return str.setValue(swapcase(str.value))
 
 		},
 	},
@@ -1461,14 +1793,7 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 			}
 
 			str := receiver.(*StringObject)
-			strLength := utf8.RuneCountInString(str.value)
-			e := []Object{}
-
-			for i := 0; i < strLength; i++ {
-				e = append(e, t.vm.InitStringObject(string([]rune(str.value)[i])))
-			}
-
-			return t.vm.InitArrayObject(e)
+			return t.vm.InitArrayObject(str.chars(t))
 
 		},
 	},
@@ -1519,15 +1844,17 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 	},
 	{
 		// Returns the result of converting self to Float.
-		// Passing a non-numerical string returns a 0.0 value, except trailing whitespace,
-		// which is ignored.
+		// Leading whitespace is ignored, and the string is parsed up to the first character
+		// which is not a part of a number. Passing a non-numerical string returns a 0.0 value.
 		//
 		// ```ruby
 		// "123.5".to_f     # => 123.5
-		// ".5".to_f      	# => 0.5
+		// ".5".to_f        # => 0.5
 		// "  3.5".to_f     # => 3.5
+		// "-3.5".to_f      # => -3.5
 		// "3.5e2".to_f     # => 350
-		// "3.5ef".to_f     # => 0
+		// "3.14xyz".to_f   # => 3.14
+		// "some text".to_f # => 0.0
 		// ```
 		//
 		// @return [Float]
@@ -1539,65 +1866,51 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 			str := receiver.(*StringObject).value
 
-			for i, char := range str {
-				if !unicode.IsSpace(char) {
-					str = str[i:]
-					break
-				}
-			}
-
-			parsedStr, ok := strconv.ParseFloat(str, 64)
-
-			if ok != nil {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, str)
-			}
-
-			return t.vm.initFloatObject(parsedStr)
+			return t.vm.initFloatObject(parseFloatPrefix(str))
 
 		},
 	},
 	{
-		// Returns the result of converting self to Integer.
-		// Passing a non-numerical string returns a 0 value.
+		// Returns the result of converting self to Integer, interpreting the digits in the given base (10 by default).
+		// Leading whitespace is ignored, and the string is parsed up to the first character
+		// which is not a digit of the base. Passing a non-numerical string returns a 0 value.
+		// The base should be between 2 and 36.
 		//
 		// ```ruby
 		// "123".to_i       # => 123
 		// "3d print".to_i  # => 3
 		// "  321".to_i     # => 321
+		// "-42abc".to_i    # => -42
 		// "some text".to_i # => 0
+		// "ff".to_i(16)    # => 255
+		// "101".to_i(2)    # => 5
 		// ```
 		//
+		// @param base [Integer]
 		// @return [Integer]
 		Name: "to_i",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			if len(args) != 0 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			aLen := len(args)
+			if aLen > 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, aLen)
 			}
 
-			str := receiver.(*StringObject).value
-			parsedStr, err := strconv.ParseInt(str, 10, 0)
-
-			if err == nil {
-				return t.vm.InitIntegerObject(int(parsedStr))
-			}
+			base := 10
+			if aLen == 1 {
+				b, ok := args[0].(*IntegerObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+				}
 
-			var digits string
-			for _, char := range str {
-				if unicode.IsDigit(char) {
-					digits += string(char)
-				} else if unicode.IsSpace(char) && len(digits) == 0 {
-					// do nothing; allow trailing spaces
-				} else {
-					break
+				if b.value < 2 || b.value > 36 {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidRadix, b.value)
 				}
+				base = b.value
 			}
 
-			if len(digits) > 0 {
-				parsedStr, _ = strconv.ParseInt(digits, 10, 0)
-				return t.vm.InitIntegerObject(int(parsedStr))
-			}
+			str := receiver.(*StringObject).value
 
-			return t.vm.InitIntegerObject(0)
+			return t.vm.InitIntegerObject(parseIntegerPrefix(str, base))
 
 		},
 	},
@@ -1620,6 +1933,26 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 			return t.vm.InitStringObject(str)
 		},
   },
+	{
+		// Returns the symbol of self. Since symbols are String objects in Goby, it's equivalent to `to_s`.
+		//
+		// ```ruby
+		// "string".to_sym            # => :string
+		// "string".to_sym == :string # => true
+		// ```
+		//
+		// @return [String]
+		Name: "to_sym",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			str := receiver.(*StringObject).value
+
+			return t.vm.InitStringObject(str)
+		},
+	},
 	{
 		// Returns a new String which would evaluate to self value
     //
@@ -1653,6 +1986,32 @@ var builtinStringInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// A destructive method.
+		// Converts all characters of self to uppercase.
+		// Returns self, or `nil` if no changes were made.
+		//
+		// ```ruby
+		// a = "very big"
+		// a.upcase! # => "VERY BIG"
+		// a         # => "VERY BIG"
+		// a.upcase! # => nil
+		// ```
+		//
+		// @return [String]
+		Name: "upcase!",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+
+			if receiver.isFrozen() {
+				return t.vm.InitFrozenError(sourceLine, receiver)
+			}
+
+			str := receiver.(*StringObject)
+
+			return str.setValue(strings.ToUpper(str.value))
+
+		},
+	},
 }
 
 // Internal functions ===================================================
@@ -1690,6 +2049,143 @@ func (s *StringObject) Inspect() string {
 	return fmt.Sprintf(`"%s"`, escapeSpecialChars(escapeBackslash(s.ToString())))
 }
 
+// whitespaceChars are the characters removed by the methods like `strip`
+const whitespaceChars = "\x00\t\n\v\f\r "
+
+// parseIntegerPrefix parses the leading integer of the string in the given base, ignoring leading whitespace.
+// Returns 0 if the string doesn't start with a digit.
+func parseIntegerPrefix(str string, base int) int {
+	str = strings.TrimLeftFunc(str, unicode.IsSpace)
+
+	end := 0
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		end = 1
+	}
+
+	start := end
+	for end < len(str) && digitValue(rune(str[end])) < base {
+		end++
+	}
+
+	if end == start {
+		return 0
+	}
+
+	i, _ := strconv.ParseInt(str[:end], base, 0)
+	return int(i)
+}
+
+// parseFloatPrefix parses the leading floating-point number of the string, ignoring leading whitespace.
+// Returns 0.0 if the string doesn't start with a number.
+func parseFloatPrefix(str string) float64 {
+	str = strings.TrimLeftFunc(str, unicode.IsSpace)
+
+	end := 0
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		end = 1
+	}
+
+	digitsFrom := func(i int) int {
+		for i < len(str) && str[i] >= '0' && str[i] <= '9' {
+			i++
+		}
+		return i
+	}
+
+	intEnd := digitsFrom(end)
+	hasDigits := intEnd > end
+	end = intEnd
+
+	if end < len(str) && str[end] == '.' {
+		if fracEnd := digitsFrom(end + 1); fracEnd > end+1 {
+			hasDigits = true
+			end = fracEnd
+		}
+	}
+
+	if !hasDigits {
+		return 0
+	}
+
+	// The exponent is only a part of the number if it has digits
+	if end < len(str) && (str[end] == 'e' || str[end] == 'E') {
+		expStart := end + 1
+		if expStart < len(str) && (str[expStart] == '-' || str[expStart] == '+') {
+			expStart++
+		}
+
+		if expEnd := digitsFrom(expStart); expEnd > expStart {
+			end = expEnd
+		}
+	}
+
+	f, _ := strconv.ParseFloat(str[:end], 64)
+	return f
+}
+
+// digitValue returns the value of the digit in the bases up to 36, or 36 if it isn't a digit
+func digitValue(r rune) int {
+	switch {
+	case '0' <= r && r <= '9':
+		return int(r - '0')
+	case 'a' <= r && r <= 'z':
+		return int(r-'a') + 10
+	case 'A' <= r && r <= 'Z':
+		return int(r-'A') + 10
+	}
+	return 36
+}
+
+// stringsToObjects converts the Go strings to an array of String objects
+func stringsToObjects(t *Thread, strs []string) []Object {
+	elements := make([]Object, len(strs))
+	for i, str := range strs {
+		elements[i] = t.vm.InitStringObject(str)
+	}
+
+	return elements
+}
+
+// splitByRegexp splits the string around the matches of the regexp, into at most limit strings if limit is positive.
+// Empty matches at the beginning and the end of the string are ignored, so an empty regexp splits the string into characters.
+func splitByRegexp(str string, re *Regexp, limit int) []string {
+	runes := []rune(str)
+	var result []string
+	start := 0
+
+	match, err := re.FindStringMatch(str)
+	for ; match != nil && err == nil && (limit < 0 || len(result) < limit-1); match, err = re.FindNextMatch(match) {
+		if match.Length == 0 && (match.Index == 0 || match.Index >= len(runes)) {
+			continue
+		}
+
+		result = append(result, string(runes[start:match.Index]))
+		start = match.Index + match.Length
+	}
+
+	return append(result, string(runes[start:]))
+}
+
+// capitalize converts the first character of the string to uppercase, and the rest to lowercase
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+
+	runes := []rune(s)
+	return strings.ToUpper(string(runes[0])) + strings.ToLower(string(runes[1:]))
+}
+
+// swapcase converts the uppercase characters of the string to lowercase, and vice versa
+func swapcase(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsUpper(r) {
+			return unicode.ToLower(r)
+		}
+		return unicode.ToUpper(r)
+	}, s)
+}
+
 func escapeSpecialChars(s string) string {
 	s = strings.Replace(s, "\n", `\n`, -1)
 	s = strings.Replace(s, `"`, `\"`, -1)
@@ -1709,3 +2205,403 @@ func (s *StringObject) ToJSON(t *Thread) string {
 func (s *StringObject) equal(e *StringObject) bool {
 	return s.value == e.value
 }
+
+// chars returns the characters of the string as String objects; common to `chars` and `to_a`
+func (s *StringObject) chars(t *Thread) []Object {
+	runes := []rune(s.value)
+	elements := make([]Object, len(runes))
+	for i, r := range runes {
+		elements[i] = t.vm.InitStringObject(string(r))
+	}
+
+	return elements
+}
+
+// index returns the character index of the first occurrence of the substring, or the last one if reverse is true;
+// common to `index` and `rindex`.
+func (s *StringObject) index(t *Thread, args []Object, sourceLine int, reverse bool) Object {
+	aLen := len(args)
+	if aLen < 1 || aLen > 2 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
+	}
+
+	substr, ok := args[0].(*StringObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+	}
+
+	runes := []rune(s.value)
+	subRunes := []rune(substr.value)
+
+	offset := 0
+	if reverse {
+		offset = len(runes)
+	}
+
+	if aLen == 2 {
+		o, ok := args[1].(*IntegerObject)
+		if !ok {
+			return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[1].Class().Name)
+		}
+
+		offset = o.value
+		if offset < 0 {
+			offset += len(runes)
+		}
+
+		if offset < 0 || offset > len(runes) {
+			return NULL
+		}
+	}
+
+	matchesAt := func(i int) bool {
+		return string(runes[i:i+len(subRunes)]) == substr.value
+	}
+
+	if reverse {
+		if offset > len(runes)-len(subRunes) {
+			offset = len(runes) - len(subRunes)
+		}
+
+		for i := offset; i >= 0; i-- {
+			if matchesAt(i) {
+				return t.vm.InitIntegerObject(i)
+			}
+		}
+
+		return NULL
+	}
+
+	for i := offset; i+len(subRunes) <= len(runes); i++ {
+		if matchesAt(i) {
+			return t.vm.InitIntegerObject(i)
+		}
+	}
+
+	return NULL
+}
+
+// justify pads the string with the padding string to the given length, putting the number of padding characters
+// returned by leftPadding on the left side and the rest on the right side; common to `center`, `ljust` and `rjust`.
+func (s *StringObject) justify(t *Thread, args []Object, sourceLine int, leftPadding func(padding int) int) Object {
+	aLen := len(args)
+	if aLen < 1 || aLen > 2 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentRange, 1, 2, aLen)
+	}
+
+	strLength, ok := args[0].(*IntegerObject)
+
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.IntegerClass, args[0].Class().Name)
+	}
+
+	padStrValue := " "
+
+	if aLen == 2 {
+		padStr, ok := args[1].(*StringObject)
+
+		if !ok {
+			return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, classes.StringClass, args[1].Class().Name)
+		}
+
+		if padStr.value == "" {
+			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.EmptyPadding)
+		}
+
+		padStrValue = padStr.value
+	}
+
+	// Support UTF-8 Encoding
+	padding := strLength.value - utf8.RuneCountInString(s.value)
+
+	if padding <= 0 {
+		return t.vm.InitStringObject(s.value)
+	}
+
+	left := leftPadding(padding)
+	pad := []rune(strings.Repeat(padStrValue, padding/utf8.RuneCountInString(padStrValue)+1))
+
+	return t.vm.InitStringObject(string(pad[:left]) + s.value + string(pad[:padding-left]))
+}
+
+// substring returns the string of up to the given length of characters from the start index.
+// Returns an empty string if the start index equals to the length of the string,
+// or `nil` if the start index is out of range or the length is negative.
+func (s *StringObject) substring(t *Thread, args []Object, sourceLine int) Object {
+	for i, arg := range args {
+		if _, ok := arg.(*IntegerObject); !ok {
+			return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, i+1, classes.IntegerClass, arg.Class().Name)
+		}
+	}
+
+	runes := []rune(s.value)
+	start := args[0].(*IntegerObject).value
+	length := args[1].(*IntegerObject).value
+
+	if start < 0 {
+		start += len(runes)
+	}
+
+	if start < 0 || start > len(runes) || length < 0 {
+		return NULL
+	}
+
+	end := start + length
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	return t.vm.InitStringObject(string(runes[start:end]))
+}
+
+// sliceByRange returns the substring covered by the range, counting negative bounds from the end;
+// common to `[]` and `slice`. Returns `nil` if the start is out of range.
+func (s *StringObject) sliceByRange(t *Thread, r *RangeObject) Object {
+	runes := []rune(s.value)
+	start, end := r.Start, r.End
+
+	if start < 0 {
+		start += len(runes)
+	}
+	if end < 0 {
+		end += len(runes)
+	}
+	if r.Exclusive {
+		end--
+	}
+
+	if start < 0 || start > len(runes) {
+		return NULL
+	}
+
+	if end >= len(runes) {
+		end = len(runes) - 1
+	}
+
+	if start > end {
+		return t.vm.InitStringObject("")
+	}
+
+	return t.vm.InitStringObject(string(runes[start : end+1]))
+}
+
+// replace substitutes the matches of the pattern for the replacement, or for the results of the block if given,
+// up to count times, or all of them if count is -1; common to `gsub`, `replace`, `replace_once` and `sub`.
+func (s *StringObject) replace(t *Thread, args []Object, blockFrame *normalCallFrame, sourceLine int, count int) Object {
+	if blockFrame != nil && len(args) == 1 {
+		return s.replaceWithBlock(t, args[0], blockFrame, sourceLine, count)
+	}
+
+	if len(args) != 2 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 2, len(args))
+	}
+
+	replacement, ok := args[1].(*StringObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 2, classes.StringClass, args[1].Class().Name)
+	}
+
+	var result string
+	var err error
+	switch pattern := args[0].(type) {
+	case *StringObject:
+		result = strings.Replace(s.value, pattern.value, replacement.value, count)
+	case *RegexpObject:
+		result, err = pattern.regexp.Replace(s.value, replacement.value, 0, count)
+		if err != nil {
+			return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.RegexpFailure, args[0].Class().Name)
+		}
+	default:
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.StringClass+" or "+classes.RegexpClass, args[0].Class().Name)
+	}
+
+	return t.vm.InitStringObject(result)
+}
+
+// replaceWithBlock substitutes the matches of the pattern for the results of the block, up to count times
+func (s *StringObject) replaceWithBlock(t *Thread, pattern Object, blockFrame *normalCallFrame, sourceLine int, count int) Object {
+	emptyBlock := blockIsEmpty(blockFrame)
+	yields := 0
+	substitute := func(match string) string {
+		if emptyBlock {
+			return ""
+		}
+
+		yields++
+		return t.builtinMethodYield(blockFrame, t.vm.InitStringObject(match)).Target.ToString()
+	}
+
+	var result string
+	switch p := pattern.(type) {
+	case *StringObject:
+		var out bytes.Buffer
+
+		if p.value == "" {
+			// Like strings.Replace, an empty pattern matches before each rune and after the last one
+			runes := []rune(s.value)
+			for i := 0; i <= len(runes); i++ {
+				if count < 0 || i < count {
+					out.WriteString(substitute(""))
+				}
+				if i < len(runes) {
+					out.WriteRune(runes[i])
+				}
+			}
+		} else {
+			var parts []string
+			if count < 0 {
+				parts = strings.Split(s.value, p.value)
+			} else {
+				parts = strings.SplitN(s.value, p.value, count+1)
+			}
+
+			for i, part := range parts {
+				if i > 0 {
+					out.WriteString(substitute(p.value))
+				}
+				out.WriteString(part)
+			}
+		}
+		result = out.String()
+	case *RegexpObject:
+		var err error
+		result, err = p.regexp.ReplaceFunc(s.value, func(m regexp2.Match) string {
+			return substitute(m.String())
+		}, 0, count)
+		if err != nil {
+			return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.RegexpFailure, pattern.Class().Name)
+		}
+	default:
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormatNum, 1, classes.StringClass+" or "+classes.RegexpClass, pattern.Class().Name)
+	}
+
+	// If nothing was yielded, pop the block's call frame
+	if !emptyBlock && yields == 0 {
+		t.callFrameStack.pop()
+	}
+
+	return t.vm.InitStringObject(result)
+}
+
+// setValue replaces the value of the string and returns the string itself,
+// or returns `nil` if the value is unchanged; common to the destructive methods like `upcase!`
+func (s *StringObject) setValue(value string) Object {
+	if s.value == value {
+		return NULL
+	}
+
+	s.value = value
+	return s
+}
+
+// format returns the string formatted with the arguments as a printf-style format string; common to `%` and `sprintf`.
+// Supports the `d`, `i`, `s`, `f`, `e`, `g`, `x`, `X`, `o` and `b` verbs with the `-`, `+`, ` `, `0` and `#` flags,
+// width and precision.
+func (s *StringObject) format(t *Thread, args []Object, sourceLine int) Object {
+	var buf bytes.Buffer
+	format := s.value
+	count := 0
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			buf.WriteByte(format[i])
+			continue
+		}
+
+		start := i
+		i++
+
+		for i < len(format) && strings.IndexByte("-+ 0#", format[i]) != -1 {
+			i++
+		}
+
+		flags := format[start+1 : i]
+
+		for i < len(format) && unicode.IsDigit(rune(format[i])) {
+			i++
+		}
+
+		if i < len(format) && format[i] == '.' {
+			i++
+
+			for i < len(format) && unicode.IsDigit(rune(format[i])) {
+				i++
+			}
+		}
+
+		if i >= len(format) {
+			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.MalformedFormatString, format[start:])
+		}
+
+		verb := format[i]
+
+		if verb == '%' && i == start+1 {
+			buf.WriteByte('%')
+			continue
+		}
+
+		if strings.IndexByte("dixXobfegs", verb) == -1 {
+			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.MalformedFormatString, format[start:i+1])
+		}
+
+		spec := format[start:i]
+
+		if count >= len(args) {
+			count++
+			continue
+		}
+
+		arg := args[count]
+		count++
+
+		switch verb {
+		case 'd', 'i', 'x', 'X', 'o', 'b':
+			var value int
+
+			switch n := arg.(type) {
+			case *IntegerObject:
+				value = n.value
+			case *FloatObject:
+				value = int(math.Floor(n.value))
+			default:
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, arg.Class().Name)
+			}
+
+			if verb == 'i' {
+				verb = 'd'
+			}
+
+			fmt.Fprintf(&buf, spec+string(verb), value)
+		case 'f', 'e', 'g':
+			var value float64
+
+			switch n := arg.(type) {
+			case *IntegerObject:
+				value = float64(n.value)
+			case *FloatObject:
+				value = n.value
+			case *DecimalObject:
+				value, _ = n.value.Float64()
+			default:
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", arg.Class().Name)
+			}
+
+			// Like Ruby, `g` defaults to 6 significant digits rather than Go's shortest representation
+			if verb == 'g' && !strings.Contains(spec, ".") {
+				spec += ".6"
+			}
+
+			fmt.Fprintf(&buf, spec+string(verb), value)
+		case 's':
+			// Like Ruby, the `0` flag doesn't pad strings with zeros
+			spec = "%" + strings.Replace(flags, "0", "", -1) + spec[1+len(flags):]
+			fmt.Fprintf(&buf, spec+"s", arg.ToString())
+		}
+	}
+
+	if count != len(args) {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, count, len(args))
+	}
+
+	return t.vm.InitStringObject(buf.String())
+}
@@ -0,0 +1,290 @@
+package vm
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// StringIOObject is an in-memory buffer that can be written like an IO.
+// It's useful for building a large string without repeated string concatenation, or for capturing outputs in tests.
+//
+// ```ruby
+// io = StringIO.new
+// io << "a" << "b"
+// io.puts("c")
+// io.print(1, 2)
+// io.string #=> "ab\nc\n12"
+// ```
+//
+// Writing starts at the current position, which is moved to the beginning by `rewind`,
+// so writing after `rewind` overwrites the buffer from its beginning.
+type StringIOObject struct {
+	*BaseObj
+	buffer bytes.Buffer
+	pos    int
+}
+
+// Class methods --------------------------------------------------------
+var builtinStringIOClassMethods = []*BuiltinMethodObject{
+	{
+		// Creates a StringIO with the optional initial string.
+		// The position starts at the beginning, so writing overwrites the initial string.
+		//
+		// ```ruby
+		// StringIO.new.string          #=> ""
+		// StringIO.new("Goby").string  #=> "Goby"
+		// ```
+		//
+		// @param string [String]
+		// @return [StringIO]
+		Name: "new",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			io := t.vm.initStringIOObject()
+
+			switch len(args) {
+			case 0:
+			case 1:
+				s, ok := args[0].(*StringObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+				}
+				io.buffer.WriteString(s.value)
+			default:
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgumentLess, 1, len(args))
+			}
+
+			return io
+
+		},
+	},
+}
+
+// Instance methods -----------------------------------------------------
+var builtinStringIOInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Writes the object as a string, and returns self so the calls can be chained.
+		//
+		// ```ruby
+		// io = StringIO.new
+		// io << "a" << 1
+		// io.string #=> "a1"
+		// ```
+		//
+		// @param object [Object]
+		// @return [StringIO]
+		Name: "<<",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			io := receiver.(*StringIOObject)
+			io.Write([]byte(args[0].ToString()))
+
+			return io
+
+		},
+	},
+	{
+		// Writes the objects as strings without any separators, and returns nil.
+		//
+		// ```ruby
+		// io = StringIO.new
+		// io.print("a", 1, nil)
+		// io.string #=> "a1"
+		// ```
+		//
+		// @param *objects [Object]
+		// @return [Null]
+		Name: "print",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			io := receiver.(*StringIOObject)
+
+			for _, arg := range args {
+				fmt.Fprint(io, arg.ToString())
+			}
+
+			return NULL
+
+		},
+	},
+	{
+		// Writes the objects like `puts` does, each followed by a line feed, and returns nil.
+		// Elements of an array are written one per line, and a line feed is written if no objects are given.
+		//
+		// ```ruby
+		// io = StringIO.new
+		// io.puts("a", [1, 2])
+		// io.puts
+		// io.string #=> "a\n1\n2\n\n"
+		// ```
+		//
+		// @param *objects [Object]
+		// @return [Null]
+		Name: "puts",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			io := receiver.(*StringIOObject)
+
+			if len(args) == 0 {
+				fmt.Fprintln(io)
+			}
+
+			for _, arg := range args {
+				putsObject(io, arg)
+			}
+
+			return NULL
+
+		},
+	},
+	{
+		// Returns the rest of the buffer from the current position, and moves the position to the end.
+		//
+		// ```ruby
+		// io = StringIO.new("Goby")
+		// io.read #=> "Goby"
+		// io.read #=> ""
+		// ```
+		//
+		// @return [String]
+		Name: "read",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			io := receiver.(*StringIOObject)
+			rest := string(io.buffer.Bytes()[io.pos:])
+			io.pos = io.buffer.Len()
+
+			return t.vm.InitStringObject(rest)
+
+		},
+	},
+	{
+		// Moves the position to the beginning of the buffer and returns 0.
+		// Reading starts over from the beginning, and writing overwrites the buffer.
+		//
+		// ```ruby
+		// io = StringIO.new
+		// io << "abc"
+		// io.rewind
+		// io << "x"
+		// io.string #=> "xbc"
+		// ```
+		//
+		// @return [Integer]
+		Name: "rewind",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			receiver.(*StringIOObject).pos = 0
+
+			return t.vm.InitIntegerObject(0)
+
+		},
+	},
+	{
+		// Returns the whole content of the buffer regardless of the position.
+		//
+		// ```ruby
+		// io = StringIO.new
+		// io.write("Hello", " ", "Goby")
+		// io.string #=> "Hello Goby"
+		// ```
+		//
+		// @return [String]
+		Name: "string",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			return t.vm.InitStringObject(receiver.(*StringIOObject).buffer.String())
+
+		},
+	},
+	{
+		// Writes the objects as strings, and returns the number of bytes written.
+		//
+		// ```ruby
+		// io = StringIO.new
+		// io.write("a", 12) #=> 3
+		// io.write("🍣")     #=> 4
+		// io.string         #=> "a12🍣"
+		// ```
+		//
+		// @param *objects [Object]
+		// @return [Integer]
+		Name: "write",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			io := receiver.(*StringIOObject)
+			var written int
+
+			for _, arg := range args {
+				n, _ := io.Write([]byte(arg.ToString()))
+				written += n
+			}
+
+			return t.vm.InitIntegerObject(written)
+
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initStringIOObject() *StringIOObject {
+	return &StringIOObject{
+		BaseObj: &BaseObj{class: vm.TopLevelClass(classes.StringIOClass)},
+	}
+}
+
+func (vm *VM) initStringIOClass() *RClass {
+	sc := vm.initializeClass(classes.StringIOClass)
+	sc.setBuiltinMethods(builtinStringIOClassMethods, true)
+	sc.setBuiltinMethods(builtinStringIOInstanceMethods, false)
+	return sc
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the content of the buffer
+func (io *StringIOObject) Value() interface{} {
+	return io.buffer.String()
+}
+
+// ToString returns the object's name as the string format
+func (io *StringIOObject) ToString() string {
+	return "#<StringIO>"
+}
+
+// Inspect delegates to ToString
+func (io *StringIOObject) Inspect() string {
+	return io.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (io *StringIOObject) ToJSON(t *Thread) string {
+	return "\"" + io.ToString() + "\""
+}
+
+// Write writes the bytes at the current position, overwriting the buffer after `rewind`, so StringIOObject is an io.Writer
+func (io *StringIOObject) Write(p []byte) (int, error) {
+	if io.pos == io.buffer.Len() {
+		io.buffer.Write(p)
+	} else {
+		n := copy(io.buffer.Bytes()[io.pos:], p)
+		io.buffer.Write(p[n:])
+	}
+
+	io.pos += len(p)
+	return len(p), nil
+}
@@ -0,0 +1,188 @@
+package vm
+
+import (
+	"testing"
+)
+
+func TestStringIOClass(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`StringIO.class.name`, "Class"},
+		{`StringIO.superclass.name`, "Object"},
+		{`StringIO.new.class.name`, "StringIO"},
+		{`StringIO.new.to_s`, "#<StringIO>"},
+		{`StringIO.new.string`, ""},
+		{`StringIO.new("Goby").string`, "Goby"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringIOWritingMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`
+		io = StringIO.new
+		io << "a"
+		io << "b"
+		io.string
+		`, "ab"},
+		{`
+		io = StringIO.new
+		io << "a" << 1 << nil << [1, "b"]
+		io.string
+		`, `a1[1, "b"]`},
+		{`
+		io = StringIO.new
+		(io << "a").to_s
+		`, "#<StringIO>"},
+		{`
+		io = StringIO.new
+		io.write("Hello", " ", "Goby")
+		io.string
+		`, "Hello Goby"},
+		{`StringIO.new.write("a", 12)`, 3},
+		{`StringIO.new.write("🍣")`, 4},
+		{`StringIO.new.write`, 0},
+		{`
+		io = StringIO.new
+		io.print("a", 1, nil, :b)
+		io.string
+		`, "a1b"},
+		{`StringIO.new.print("a")`, nil},
+		{`
+		io = StringIO.new
+		io.puts("a", 1)
+		io.string
+		`, "a\n1\n"},
+		{`
+		io = StringIO.new
+		io.puts([1, [2, 3]], [])
+		io.string
+		`, "1\n2\n3\n\n"},
+		{`
+		io = StringIO.new
+		io.puts
+		io.string
+		`, "\n"},
+		{`StringIO.new.puts("a")`, nil},
+		{`
+		io = StringIO.new
+		io.write("a")
+		io.puts("b")
+		io << "c"
+		io.print("d", "e")
+		io.puts
+		io.write("f")
+		io.puts("g", "h")
+		io.string
+		`, "ab\ncde\nfg\nh\n"},
+		{`
+		io = StringIO.new
+		100.times do |i|
+		  io << i
+		end
+		io.string.length
+		`, 190},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringIORewindAndReadMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`StringIO.new("Goby").read`, "Goby"},
+		{`
+		io = StringIO.new("Goby")
+		io.read
+		io.read
+		`, ""},
+		{`
+		io = StringIO.new
+		io << "abc"
+		io.read
+		`, ""},
+		{`
+		io = StringIO.new
+		io << "abc"
+		io.rewind
+		io.read
+		`, "abc"},
+		{`
+		io = StringIO.new
+		io << "abc"
+		io.rewind
+		`, 0},
+		{`
+		io = StringIO.new
+		io << "abc"
+		io.rewind
+		io << "x"
+		io.string
+		`, "xbc"},
+		{`
+		io = StringIO.new
+		io << "abc"
+		io.rewind
+		io << "wxyz"
+		io.string
+		`, "wxyz"},
+		{`
+		io = StringIO.new("abc")
+		io << "x"
+		io.read
+		`, "bc"},
+		{`
+		io = StringIO.new("abc")
+		io.read
+		io << "d"
+		io.string
+		`, "abcd"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringIOMethodsFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`StringIO.new(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`StringIO.new("a", "b")`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`StringIO.new.send("<<")`, "ArgumentError: Expect 1 argument(s). got: 0", 2},
+		{`StringIO.new.string(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`StringIO.new.rewind(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`StringIO.new.read(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
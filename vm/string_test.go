@@ -66,6 +66,52 @@ func TestStringFmtMethodFail(t *testing.T) {
 	}
 }
 
+func TestStringPercentMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"%.2f" % 3.14159`, "3.14"},
+		{`"%05d" % 42`, "00042"},
+		{`"%s is %d" % ["Goby", 5]`, "Goby is 5"},
+		{`"%s" % [[1, 2]]`, "[1, 2]"},
+		{`"100%%" % []`, "100%"},
+		{`"%s!" % :symbol`, "symbol!"},
+		{`"%05s" % "ab"`, "   ab"},
+		{`"%-05s|" % "ab"`, "ab   |"},
+		{`"%g" % 1234567.0`, "1.23457e+06"},
+		{`"%g" % 0.5`, "0.5"},
+		{`"%.2g" % 1234.0`, "1.2e+03"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringPercentMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"%d" % "Goby"`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`"%f" % "Goby"`, "TypeError: Expect argument to be Numeric. got: String", 1},
+		{`"%s and %s" % ["Goby"]`, "ArgumentError: Expect 2 argument(s). got: 1", 1},
+		{`"%s" % ["Goby", "Ruby"]`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`"%z" % 1`, "ArgumentError: Malformed format string: %z", 1},
+		{`"100%" % []`, "ArgumentError: Malformed format string: %", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestEvalStringExpression(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -126,6 +172,7 @@ func TestStringComparison(t *testing.T) {
 		{`"一" <=> "🍣"`, -1},
 		{`"🍺" <=> "🍣"`, 1},
 		{`"🍣" <=> "🍺"`, -1},
+		{`"a" <=> 1`, nil},
 	}
 
 	for i, tt := range tests {
@@ -141,7 +188,6 @@ func TestStringComparisonFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`"a" < 1`, "TypeError: Expect argument to be String. got: Integer", 1},
 		{`"a" > 1`, "TypeError: Expect argument to be String. got: Integer", 1},
-		{`"a" <=> 1`, "TypeError: Expect argument to be String. got: Integer", 1},
 	}
 	for i, tt := range testsFail {
 		v := initTestVM()
@@ -194,6 +240,10 @@ func TestStringOperation(t *testing.T) {
 		{`"Three " * 3`, "Three Three Three "},
 		{`"Zero" * 0`, ""},
 		{`"Minus" * 1`, "Minus"},
+		{`"ab" * 3`, "ababab"},
+		{`"🍣" * 2`, "🍣🍣"},
+		{`"" * 5`, ""},
+		{`"-" * 3 + "|"`, "---|"},
 		{`"Hello"[1]`, "e"},
 		{`"Hello"[5]`, nil},
 		{`"Hello"[-1]`, "o"},
@@ -206,6 +256,13 @@ func TestStringOperation(t *testing.T) {
 		{`"Hello"[-6..-1]`, nil},
 		{`"Hello🍣"[-3..-3]`, "l"},
 		{`"Hello🍣"[1..-1]`, "ello🍣"},
+		{`"Hello"[3..1]`, ""},
+		{`"Hello"[1...4]`, "ell"},
+		{`"Hello"[1...-1]`, "ell"},
+		{`"Hello"[-3...5]`, "llo"},
+		{`"Hello"[1...10]`, "ello"},
+		{`"Hello"[2...2]`, ""},
+		{`"Hello"[6...7]`, nil},
 		{`"Hello\nWorld"[5]`, "\n"},
 		{`"\"Maxwell\""[0]`, "\""},
 		{`"\"Maxwell\""[-1]`, "\""},
@@ -241,6 +298,8 @@ func TestStringOperationFail(t *testing.T) {
 		{`"Taipei" + 101`, "TypeError: Expect argument to be String. got: Integer", 1},
 		{`"Taipei" * "101"`, "TypeError: Expect argument to be Integer. got: String", 1},
 		{`"Taipei" * (-101)`, "ArgumentError: Expect second argument to be positive value. got: -101", 1},
+		{`"Taipei" * 1.5`, "TypeError: Expect argument to be Integer. got: Float", 1},
+		{`"Taipei" * nil`, "TypeError: Expect argument to be Integer. got: Null", 1},
 		{`"Taipei"[1] = 1`, "TypeError: Expect argument to be String. got: Integer", 1},
 		{`"Taipei"[1] = true`, "TypeError: Expect argument to be String. got: Boolean", 1},
 		{`"Taipei"[]`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
@@ -260,6 +319,40 @@ func TestStringOperationFail(t *testing.T) {
 
 // Method test
 
+func TestStringBytesMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"Goby".bytes.to_s`, "[71, 111, 98, 121]"},
+		{`"é".bytes.to_s`, "[195, 169]"},
+		{`"🍣".bytes.length`, 4},
+		{`"".bytes.to_s`, "[]"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringBytesMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"Taipei".bytes(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestStringCapitalizeMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -276,6 +369,108 @@ func TestStringCapitalizeMethod(t *testing.T) {
 		{`"🍣HeLlO🍺".capitalize`, "🍣hello🍺"},
 		{`"ÀÁÂÃÄÅÆÇÈÉÊËÌÍÎÏÑÒÓÔÕÖØŒŠÙÚÛÜÝŸ".capitalize`, "Àáâãäåæçèéêëìíîïñòóôõöøœšùúûüýÿ"},
 		{`"ΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩ".capitalize`, "Αβγδεζηθικλμνξοπρστυφχψω"},
+		{`"hello World".capitalize`, "Hello world"},
+		{`"".capitalize`, ""},
+		{`
+		a = "hello"
+		a.capitalize
+		a
+		`, "hello"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringCapitalizeBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"hello World".capitalize!`, "Hello world"},
+		{`
+		a = "hello World"
+		a.capitalize!
+		a
+		`, "Hello world"},
+		{`
+		a = "hello World"
+		a.capitalize!.object_id == a.object_id
+		`, true},
+		{`"Hello world".capitalize!`, nil},
+		{`
+		a = "Hello world"
+		a.capitalize!
+		a
+		`, "Hello world"},
+		{`"".capitalize!`, nil},
+		{`"123".capitalize!`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringCenterMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"Hello".center(2)`, "Hello"},
+		{`"Hello".center(5)`, "Hello"},
+		{`"Hello".center(7)`, " Hello "},
+		{`"Hello".center(8)`, " Hello  "},
+		{`"Hello".center(11, "xo")`, "xoxHelloxox"},
+		{`"Hello".center(10, "🍣🍺")`, "🍣🍺Hello🍣🍺🍣"},
+		{`"".center(3, "-")`, "---"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringCenterMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"Hello".center`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`"Hello".center(1, 2, 3)`, "ArgumentError: Expect 1 to 2 argument(s). got: 3", 1},
+		{`"Hello".center("World")`, "TypeError: Expect argument #1 to be Integer. got: String", 1},
+		{`"Hello".center(10, 10)`, "TypeError: Expect argument #2 to be String. got: Integer", 1},
+		{`"Hello".center(10, "")`, "ArgumentError: Expect padding to be a non-empty String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringCharsMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"abc".chars.to_s`, `["a", "b", "c"]`},
+		{`"🍣Hello🍺".chars.to_s`, `["🍣", "H", "e", "l", "l", "o", "🍺"]`},
+		{`"漢字".chars.length`, 2},
+		{`"".chars.to_s`, "[]"},
 	}
 
 	for i, tt := range tests {
@@ -287,6 +482,20 @@ func TestStringCapitalizeMethod(t *testing.T) {
 	}
 }
 
+func TestStringCharsMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"Taipei".chars(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestStringChopMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -419,6 +628,39 @@ func TestStringDowncaseMethod(t *testing.T) {
 	}
 }
 
+func TestStringDowncaseBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"HeLlO 123".downcase!`, "hello 123"},
+		{`
+		a = "HeLlO 123"
+		a.downcase!
+		a
+		`, "hello 123"},
+		{`
+		a = "HeLlO 123"
+		a.downcase!.object_id == a.object_id
+		`, true},
+		{`"hello 123".downcase!`, nil},
+		{`
+		a = "hello 123"
+		a.downcase!
+		a
+		`, "hello 123"},
+		{`"".downcase!`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestStringEachByteMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -652,6 +894,8 @@ func TestStringEmptyMethod(t *testing.T) {
 	}{
 		{`"".empty?`, true},
 		{`"Hello".empty?`, false},
+		{`" ".empty?`, false},
+		{`"Hello".slice(5, 1).empty?`, true},
 	}
 
 	for i, tt := range tests {
@@ -663,6 +907,21 @@ func TestStringEmptyMethod(t *testing.T) {
 	}
 }
 
+func TestStringEmptyMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"".empty?(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`"Goby".empty?("a", "b")`, "ArgumentError: Expect 0 argument(s). got: 2", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestStringEqualMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -701,6 +960,105 @@ func TestStringEqualMethodFail(t *testing.T) {
 	}
 }
 
+func TestStringGsubMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"a-b-c".gsub("-", "_")`, "a_b_c"},
+		{`"a-b-c".gsub("x", "_")`, "a-b-c"},
+		{`"🍣Ruby🍣Lang".gsub("🍣", "🍺")`, "🍺Ruby🍺Lang"},
+		{`
+		re = Regexp.new("(Ru|ru)")
+		"Ruby Lang ruby lang".gsub(re, "Go")
+		`, "Goby Lang Goby lang"},
+		{`
+		"a-b-c".gsub("-") do |m|
+		  m + m
+		end
+		`, "a--b--c"},
+		{`
+		i = 0
+		"a-b-c".gsub("-") do |m|
+		  i += 1
+		  i
+		end
+		`, "a1b2c"},
+		{`
+		"a-b-c".gsub("x") do |m|
+		  m + m
+		end
+		`, "a-b-c"},
+		{`
+		"a-b-c".gsub("-") do |m|
+		end
+		`, "abc"},
+		{`"ab".gsub("", "-")`, "-a-b-"},
+		{`
+		"ab".gsub("") do |m|
+		  "-"
+		end
+		`, "-a-b-"},
+		{`
+		n = 0
+		"ab".gsub("") do |m|
+		  n += 1
+		  n.to_s
+		end
+		`, "1a2b3"},
+		{`
+		"".gsub("") do |m|
+		  "-"
+		end
+		`, "-"},
+		{`
+		re = Regexp.new("[0-9]+")
+		"a1b22c333".gsub(re) do |m|
+		  m.length.to_s
+		end
+		`, "a1b2c3"},
+		{`
+		re = Regexp.new("[0-9]+")
+		"abc".gsub(re) do |m|
+		  m
+		end
+		`, "abc"},
+		{`
+		a = "a-b"
+		a.gsub("-", "_")
+		a
+		`, "a-b"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringGsubMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"Invalid".gsub`, "ArgumentError: Expect 2 argument(s). got: 0", 1},
+		{`"Invalid".gsub("string")`, "ArgumentError: Expect 2 argument(s). got: 1", 1},
+		{`"Invalid".gsub(true, "replacement")`, "TypeError: Expect argument #1 to be String or Regexp. got: Boolean", 1},
+		{`"Invalid".gsub("pattern", 1)`, "TypeError: Expect argument #2 to be String. got: Integer", 1},
+		{`"Invalid".gsub(1) do |m|
+		  m
+		end`, "TypeError: Expect argument #1 to be String or Regexp. got: Integer", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestStringIncludeMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -742,6 +1100,54 @@ func TestStringIncludeMethodFail(t *testing.T) {
 	}
 }
 
+func TestStringIndexMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"abcabc".index("bc")`, 1},
+		{`"abcabc".index("abc")`, 0},
+		{`"abcabc".index("x")`, nil},
+		{`"abcabc".index("")`, 0},
+		{`"".index("")`, 0},
+		{`"abcabc".index("bc", 1)`, 1},
+		{`"abcabc".index("bc", 2)`, 4},
+		{`"abcabc".index("bc", 5)`, nil},
+		{`"abcabc".index("bc", -2)`, 4},
+		{`"abcabc".index("bc", -7)`, nil},
+		{`"abcabc".index("", 6)`, 6},
+		{`"abcabc".index("", 7)`, nil},
+		// Indices count UTF-8 runes, not bytes
+		{`"🍣abc".index("bc")`, 2},
+		{`"漢字漢字".index("字", 2)`, 3},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringIndexMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"abc".index`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`"abc".index("a", 1, 2)`, "ArgumentError: Expect 1 to 2 argument(s). got: 3", 1},
+		{`"abc".index(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`"abc".index("a", "1")`, "TypeError: Expect argument to be Integer. got: String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestStringInsertMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -796,6 +1202,8 @@ func TestStringLeftJustifyMethod(t *testing.T) {
 		{`"Hello".ljust(7)`, "Hello  "},
 		{`"Hello".ljust(10, "xo")`, "Helloxoxox"},
 		{`"Hello".ljust(10, "🍣🍺")`, "Hello🍣🍺🍣🍺🍣"},
+		{`"🍣".ljust(3, "x")`, "🍣xx"},
+		{`"5".ljust(3, "0")`, "500"},
 	}
 
 	for i, tt := range tests {
@@ -817,6 +1225,7 @@ func TestStringLeftJustifyMethodFail(t *testing.T) {
 		{`"Hello".ljust(10, 10)`, "TypeError: Expect argument #2 to be String. got: Integer", 1},
 		{`"Hello".ljust(10, 2..5)`, "TypeError: Expect argument #2 to be String. got: Range", 1},
 		{`"Hello".ljust(10, true)`, "TypeError: Expect argument #2 to be String. got: Boolean", 1},
+		{`"Hello".ljust(10, "")`, "ArgumentError: Expect padding to be a non-empty String", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -847,6 +1256,55 @@ func TestStringLengthMethod(t *testing.T) {
 	}
 }
 
+func TestStringLeftStripMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"  Goby Lang  ".lstrip`, "Goby Lang  "},
+		{`"\n\t\r Goby\n".lstrip`, "Goby\n"},
+		{`" \t\n\r ".lstrip`, ""},
+		{`"Goby".lstrip`, "Goby"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringLeftStripBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`" \t Goby ".lstrip!`, "Goby "},
+		{`
+		a = " \t Goby "
+		a.lstrip!
+		a
+		`, "Goby "},
+		{`
+		a = " \t Goby "
+		a.lstrip!.object_id == a.object_id
+		`, true},
+		{`"Goby ".lstrip!`, nil},
+		{`" \t\n\r ".lstrip!`, ""},
+		{`"".lstrip!`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestStringMatch(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -888,6 +1346,11 @@ func TestStringReplaceMethod(t *testing.T) {
 		{`"Ruby Lang Ruby Ruby".replace("Ru", "Go")`, "Goby Lang Goby Goby"},
 		{`"🍣Ruby🍣Lang".replace("Ru", "Go")`, "🍣Goby🍣Lang"},
 		{`re = Regexp.new("(Ru|ru)");"Ruby Lang ruby lang".replace(re, "Go")`, "Goby Lang Goby lang"},
+		{`
+		"Ruby Lang Ruby".replace("Ru") do |m|
+		  "Go"
+		end
+		`, "Goby Lang Goby"},
 	}
 
 	for i, tt := range tests {
@@ -925,6 +1388,11 @@ func TestStringReplaceOnceMethod(t *testing.T) {
 		{`"Ruby Lang Ruby Ruby".replace_once("Ru", "Go")`, "Goby Lang Ruby Ruby"},
 		{`"🍣Ruby🍣Lang Ruby".replace_once("Ru", "Go")`, "🍣Goby🍣Lang Ruby"},
 		{`re = Regexp.new("(Ru|ru)");"Ruby Lang ruby lang".replace_once(re, "Go")`, "Goby Lang ruby lang"},
+		{`
+		"Ruby Lang Ruby".replace_once("Ru") do |m|
+		  "Go"
+		end
+		`, "Goby Lang Ruby"},
 	}
 
 	for i, tt := range tests {
@@ -975,6 +1443,53 @@ func TestStringReverseMethod(t *testing.T) {
 	}
 }
 
+func TestStringRindexMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"abcabc".rindex("bc")`, 4},
+		{`"abcabc".rindex("abc")`, 3},
+		{`"abcabc".rindex("x")`, nil},
+		{`"abcabc".rindex("")`, 6},
+		{`"".rindex("")`, 0},
+		{`"abcabc".rindex("bc", 4)`, 4},
+		{`"abcabc".rindex("bc", 3)`, 1},
+		{`"abcabc".rindex("bc", 0)`, nil},
+		{`"abcabc".rindex("bc", -3)`, 1},
+		{`"abcabc".rindex("bc", -7)`, nil},
+		{`"abc".rindex("abcd")`, nil},
+		// Indices count UTF-8 runes, not bytes
+		{`"🍣abc🍣".rindex("🍣")`, 4},
+		{`"漢字漢字".rindex("漢", 1)`, 0},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringRindexMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"abc".rindex`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`"abc".rindex("a", 1, 2)`, "ArgumentError: Expect 1 to 2 argument(s). got: 3", 1},
+		{`"abc".rindex(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+		{`"abc".rindex("a", "1")`, "TypeError: Expect argument to be Integer. got: String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func TestStringRightJustifyMethod(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -984,6 +1499,8 @@ func TestStringRightJustifyMethod(t *testing.T) {
 		{`"Hello".rjust(7)`, "  Hello"},
 		{`"Hello".rjust(10, "xo")`, "xoxoxHello"},
 		{`"Hello".rjust(10, "🍣🍺")`, "🍣🍺🍣🍺🍣Hello"},
+		{`"🍣".rjust(3)`, "  🍣"},
+		{`"5".rjust(3, "0")`, "005"},
 	}
 
 	for i, tt := range tests {
@@ -1005,6 +1522,109 @@ func TestStringRightJustifyFail(t *testing.T) {
 		{`"Hello".rjust(10, 10)`, "TypeError: Expect argument #2 to be String. got: Integer", 1},
 		{`"Hello".rjust(10, 2..5)`, "TypeError: Expect argument #2 to be String. got: Range", 1},
 		{`"Hello".rjust(10, true)`, "TypeError: Expect argument #2 to be String. got: Boolean", 1},
+		{`"Hello".rjust(10, "")`, "ArgumentError: Expect padding to be a non-empty String", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringRightStripMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"  Goby Lang  ".rstrip`, "  Goby Lang"},
+		{`"\nGoby\n\t\r ".rstrip`, "\nGoby"},
+		{`" \t\n\r ".rstrip`, ""},
+		{`"Goby".rstrip`, "Goby"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringRightStripBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`" Goby \t\n".rstrip!`, " Goby"},
+		{`
+		a = " Goby \t\n"
+		a.rstrip!
+		a
+		`, " Goby"},
+		{`
+		a = " Goby \t\n"
+		a.rstrip!.object_id == a.object_id
+		`, true},
+		{`" Goby".rstrip!`, nil},
+		{`" \t\n\r ".rstrip!`, ""},
+		{`"".rstrip!`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringScanMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"a1b2c3".scan("1")`, []interface{}{"1"}},
+		{`"abcabcab".scan("bc")`, []interface{}{"bc", "bc"}},
+		{`"aaaa".scan("aa")`, []interface{}{"aa", "aa"}},
+		{`"aaa".scan("aa")`, []interface{}{"aa"}},
+		{`"a1b2c3".scan("z")`, []interface{}{}},
+		{`"".scan("a")`, []interface{}{}},
+		{`"ab".scan("")`, []interface{}{"", "", ""}},
+		{`"😊🍣😊".scan("😊")`, []interface{}{"😊", "😊"}},
+		{`"a1b2c3".scan(Regexp.new("[0-9]"))`, []interface{}{"1", "2", "3"}},
+		{`"a1b22c333".scan(Regexp.new("[0-9]+"))`, []interface{}{"1", "22", "333"}},
+		{`"abc".scan(Regexp.new("[0-9]"))`, []interface{}{}},
+		{`"a1b2c3".scan(Regexp.new("([a-z])([0-9])"))`, []interface{}{
+			[]interface{}{"a", "1"},
+			[]interface{}{"b", "2"},
+			[]interface{}{"c", "3"},
+		}},
+		{`"a1b".scan(Regexp.new("([a-z])([0-9])?"))`, []interface{}{
+			[]interface{}{"a", "1"},
+			[]interface{}{"b", nil},
+		}},
+		{`"ab".scan(Regexp.new("x*"))`, []interface{}{"", "", ""}},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringScanMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"abc".scan`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`"abc".scan("a", "b")`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`"abc".scan(1)`, "TypeError: Expect argument to be String or Regexp. got: Integer", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1060,6 +1680,10 @@ func TestStringSliceMethod(t *testing.T) {
 		{`"Hello 🍣🍺 World".slice(-10..7)`, "o 🍣🍺"},
 		{`"Hello 🍣🍺 World".slice(1..-1)`, "ello 🍣🍺 World"},
 		{`"Hello 🍣🍺 World".slice(-12..-5)`, "llo 🍣🍺 W"},
+		{`"Hello World".slice(1..20)`, "ello World"},
+		{`"Hello World".slice(1...6)`, "ello "},
+		{`"Hello World".slice(-5...-1)`, "Worl"},
+		{`"Hello 🍣🍺 World".slice(6...8)`, "🍣🍺"},
 		{`"Hello World".slice(4)`, "o"},
 		{`"Hello\nWorld".slice(5)`, "\n"},
 		{`"Hello World".slice(-3)`, "r"},
@@ -1071,6 +1695,19 @@ func TestStringSliceMethod(t *testing.T) {
 		{`"Hello 🍣🍺 World".slice(-10)`, "o"},
 		{`"Hello 🍣🍺 World".slice(-15)`, nil},
 		{`"Hello 🍣🍺 World".slice(14)`, nil},
+		{`"hello".slice(1, 3)`, "ell"},
+		{`"hello".slice(0, 5)`, "hello"},
+		{`"hello".slice(3, 10)`, "lo"},
+		{`"hello".slice(1, 0)`, ""},
+		{`"hello".slice(-3, 2)`, "ll"},
+		{`"hello".slice(-5, 1)`, "h"},
+		{`"hello".slice(-6, 1)`, nil},
+		{`"hello".slice(5, 1)`, ""},
+		{`"hello".slice(6, 1)`, nil},
+		{`"hello".slice(1, -1)`, nil},
+		{`"Hello 🍣🍺 World".slice(6, 2)`, "🍣🍺"},
+		{`"".slice(0, 1)`, ""},
+		{`"hello".slice(5)`, nil},
 	}
 
 	for i, tt := range tests {
@@ -1084,7 +1721,11 @@ func TestStringSliceMethod(t *testing.T) {
 
 func TestStringSliceMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
-		{`"Goby Lang".slice`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`"Goby Lang".slice`, "ArgumentError: Expect 1 to 2 argument(s). got: 0", 1},
+		{`"Goby Lang".slice(1, 2, 3)`, "ArgumentError: Expect 1 to 2 argument(s). got: 3", 1},
+		{`"Goby Lang".slice("1", 2)`, "TypeError: Expect argument #1 to be Integer. got: String", 1},
+		{`"Goby Lang".slice(1, nil)`, "TypeError: Expect argument #2 to be Integer. got: Null", 1},
+		{`"Goby Lang".slice(1..2, 2)`, "TypeError: Expect argument #1 to be Integer. got: Range", 1},
 		{`"Goby Lang".slice("Hello")`, "TypeError: Expect argument to be Range or Integer. got: String", 1},
 		{`"Goby Lang".slice(true)`, "TypeError: Expect argument to be Range or Integer. got: Boolean", 1},
 	}
@@ -1167,6 +1808,26 @@ func TestStringSplitMethod(t *testing.T) {
 		arr = "Hello🍺World🍣Goby".split("🍺")
 		arr[1]
 		`, "World🍣Goby"},
+		{`"a,b,c".split(",").to_s`, `["a", "b", "c"]`},
+		{`"a,b,c".split(",", 2).to_s`, `["a", "b,c"]`},
+		{`"a,b,c".split(",", 3).to_s`, `["a", "b", "c"]`},
+		{`"a,b,c".split(",", 5).to_s`, `["a", "b", "c"]`},
+		{`"a,b,c".split(",", 1).to_s`, `["a,b,c"]`},
+		{`"a,b,c".split(",", 0).to_s`, `["a", "b", "c"]`},
+		{`"a,b,c".split(",", -1).to_s`, `["a", "b", "c"]`},
+		{`"  Hello \t World\n".split.to_s`, `["Hello", "World"]`},
+		{`"Hello".split.to_s`, `["Hello"]`},
+		{`"   ".split.to_s`, `[]`},
+		{`"".split.to_s`, `[]`},
+		{`"a1b22c333d".split(/[0-9]+/).to_s`, `["a", "b", "c", "d"]`},
+		{`"a, b,c ,d".split(/\s*,\s*/).to_s`, `["a", "b", "c", "d"]`},
+		{`"1a2".split(/[0-9]/).to_s`, `["", "a", ""]`},
+		{`"abc".split(/x/).to_s`, `["abc"]`},
+		{`"abc".split(//).to_s`, `["a", "b", "c"]`},
+		{`"🍣🍺🍣".split(//).to_s`, `["🍣", "🍺", "🍣"]`},
+		{`"a🍣b🍣c".split(/🍣/, 2).to_s`, `["a", "b🍣c"]`},
+		{`"a1b2c3".split(/[0-9]/, 1).to_s`, `["a1b2c3"]`},
+		{`"aXbxc".split(/x/i).to_s`, `["a", "b", "c"]`},
 	}
 
 	for i, tt := range tests {
@@ -1180,10 +1841,11 @@ func TestStringSplitMethod(t *testing.T) {
 
 func TestStringSplitMethodFail(t *testing.T) {
 	testsFail := []errorTestCase{
-		{`"Hello World".split`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
-		{`"Hello World".split(true)`, "TypeError: Expect argument to be String. got: Boolean", 1},
-		{`"Hello World".split(123)`, "TypeError: Expect argument to be String. got: Integer", 1},
-		{`"Hello World".split(1..2)`, "TypeError: Expect argument to be String. got: Range", 1},
+		{`"Hello World".split(" ", 1, 2)`, "ArgumentError: Expect 2 or less argument(s). got: 3", 1},
+		{`"Hello World".split(" ", "1")`, "TypeError: Expect argument to be Integer. got: String", 1},
+		{`"Hello World".split(true)`, "TypeError: Expect argument to be String or Regexp. got: Boolean", 1},
+		{`"Hello World".split(123)`, "TypeError: Expect argument to be String or Regexp. got: Integer", 1},
+		{`"Hello World".split(1..2)`, "TypeError: Expect argument to be String or Regexp. got: Range", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1244,6 +1906,162 @@ func TestStringStripMethod(t *testing.T) {
 		{`"  Goby Lang   ".strip`, "Goby Lang"},
 		{`"\nGoby Lang\r\t".strip`, "Goby Lang"},
 		{`" \t 🍣 Goby Lang 🍺 \r\n ".strip`, "🍣 Goby Lang 🍺"},
+		{`"Goby\n".strip`, "Goby"},
+		{`"Goby \v\f\r".strip`, "Goby"},
+		{`" \t\n\r ".strip`, ""},
+		{`"".strip`, ""},
+		{`
+		a = "  Goby  "
+		a.strip
+		a
+		`, "  Goby  "},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringStripBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`" \t Goby \r\n".strip!`, "Goby"},
+		{`
+		a = " \t Goby \r\n"
+		a.strip!
+		a
+		`, "Goby"},
+		{`
+		a = " \t Goby \r\n"
+		a.strip!.object_id == a.object_id
+		`, true},
+		{`"Goby".strip!`, nil},
+		{`" \t\n\r ".strip!`, ""},
+		{`"".strip!`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringSubMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"a-b-c".sub("-", "_")`, "a_b-c"},
+		{`"a-b-c".sub("x", "_")`, "a-b-c"},
+		{`"ab".sub("", "-")`, "-ab"},
+		{`
+		"ab".sub("") do |m|
+		  "-"
+		end
+		`, "-ab"},
+		{`
+		re = Regexp.new("(Ru|ru)")
+		"Ruby Lang ruby lang".sub(re, "Go")
+		`, "Goby Lang ruby lang"},
+		{`
+		"a-b-c".sub("-") do |m|
+		  m + m
+		end
+		`, "a--b-c"},
+		{`
+		"a-b-c".sub("x") do |m|
+		  m + m
+		end
+		`, "a-b-c"},
+		{`
+		re = Regexp.new("[0-9]+")
+		"a1b22c333".sub(re) do |m|
+		  "<" + m + ">"
+		end
+		`, "a<1>b22c333"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringSubMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`"Invalid".sub`, "ArgumentError: Expect 2 argument(s). got: 0", 1},
+		{`"Invalid".sub("string")`, "ArgumentError: Expect 2 argument(s). got: 1", 1},
+		{`"Invalid".sub(true, "replacement")`, "TypeError: Expect argument #1 to be String or Regexp. got: Boolean", 1},
+		{`"Invalid".sub("pattern", 1)`, "TypeError: Expect argument #2 to be String. got: Integer", 1},
+		{`"Invalid".sub(1) do |m|
+		  m
+		end`, "TypeError: Expect argument #1 to be String or Regexp. got: Integer", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringSwapcaseMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"Hello World".swapcase`, "hELLO wORLD"},
+		{`"hELLO wORLD".swapcase`, "Hello World"},
+		{`"123 -_".swapcase`, "123 -_"},
+		{`"🍣HeLlO🍺".swapcase`, "🍣hElLo🍺"},
+		{`"".swapcase`, ""},
+		{`
+		a = "Hello"
+		a.swapcase
+		a
+		`, "Hello"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestStringSwapcaseBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"Hello World".swapcase!`, "hELLO wORLD"},
+		{`
+		a = "Hello World"
+		a.swapcase!
+		a
+		`, "hELLO wORLD"},
+		{`
+		a = "Hello World"
+		a.swapcase!.object_id == a.object_id
+		`, true},
+		{`"123 -_".swapcase!`, nil},
+		{`"".swapcase!`, nil},
 	}
 
 	for i, tt := range tests {
@@ -1267,6 +2085,10 @@ func TestStringConversion(t *testing.T) {
 		{`'\"Maxwell\"'.to_s`, "\\\"Maxwell\\\""},
 		{`"\'Maxwell\'".to_s`, "'Maxwell'"},
 		{`'\'Maxwell\''.to_s`, "'Maxwell'"},
+		{`"string".to_sym`, "string"},
+		{`"string".to_sym == :string`, true},
+		{`{ string: 1 }["string".to_sym]`, 1},
+		{`:string.to_sym.to_s`, "string"},
 		{`"123".to_i`, 123},
 		{`"string".to_i`, 0},
 		{`" \t123".to_i`, 123},
@@ -1276,6 +2098,26 @@ func TestStringConversion(t *testing.T) {
 		{`".5".to_f`, 0.5},
 		{`"  123.5".to_f`, 123.5},
 		{`"3.5e2".to_f`, 350.0},
+		{`"-42abc".to_i`, -42},
+		{`"+42".to_i`, 42},
+		{`"-abc".to_i`, 0},
+		{`"".to_i`, 0},
+		{`"ff".to_i(16)`, 255},
+		{`"FF".to_i(16)`, 255},
+		{`"fg".to_i(16)`, 15},
+		{`"101".to_i(2)`, 5},
+		{`"-101".to_i(2)`, -5},
+		{`"z".to_i(36)`, 35},
+		{`"9".to_i(8)`, 0},
+		{`"3.14xyz".to_f`, 3.14},
+		{`"-3.5".to_f`, -3.5},
+		{`"1.1.1".to_f`, 1.1},
+		{`"3.5ef".to_f`, 3.5},
+		{`"3.5e-1x".to_f`, 0.35},
+		{`"5.".to_f`, 5.0},
+		{`"some text".to_f`, 0.0},
+		{`".".to_f`, 0.0},
+		{`"".to_f`, 0.0},
 		{`
 		  arr = "Goby".to_a
 		  arr[0]
@@ -1368,11 +2210,13 @@ func TestStringConversionFail(t *testing.T) {
 	testsFail := []errorTestCase{
 		{`"str".to_a(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
 		{`"str".to_d(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
-		{`"str".to_i(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`"str".to_i(1)`, "ArgumentError: Invalid radix. got: 1", 1},
+		{`"str".to_i(37)`, "ArgumentError: Invalid radix. got: 37", 1},
+		{`"str".to_i(2, 3)`, "ArgumentError: Expect 1 or less argument(s). got: 2", 1},
+		{`"str".to_i("2")`, "TypeError: Expect argument to be Integer. got: String", 1},
 		{`"str".to_f(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
 		{`"str".to_s(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
-		{`"1.1.1".to_f`, "ArgumentError: Invalid numeric string. got: 1.1.1", 1},
-		{`"3.5ef".to_f`, "ArgumentError: Invalid numeric string. got: 3.5ef", 1},
+		{`"str".to_sym(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
 	}
 
 	for i, tt := range testsFail {
@@ -1406,6 +2250,39 @@ func TestStringUpcaseMethod(t *testing.T) {
 	}
 }
 
+func TestStringUpcaseBangMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"HeLlO 123".upcase!`, "HELLO 123"},
+		{`
+		a = "HeLlO 123"
+		a.upcase!
+		a
+		`, "HELLO 123"},
+		{`
+		a = "HeLlO 123"
+		a.upcase!.object_id == a.object_id
+		`, true},
+		{`"HELLO 123".upcase!`, nil},
+		{`
+		a = "HELLO 123"
+		a.upcase!
+		a
+		`, "HELLO 123"},
+		{`"".upcase!`, nil},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 // Other test
 
 func TestStringMethodChaining(t *testing.T) {
@@ -283,19 +283,63 @@ func (t *Thread) retrieveBlock(fileName, blockFlag string, sourceLine int) (bloc
 	return
 }
 
-func (t *Thread) sendMethod(methodName string, argCount int, blockFrame *normalCallFrame, sourceLine int) {
-	var method Object
+// expandSplatArguments replaces every splat array among the top argCount stack values with its elements,
+// and returns the new argument count along with an argument set that matches the expanded arguments
+func (t *Thread) expandSplatArguments(argCount int, argSet *bytecode.ArgSet) (int, *bytecode.ArgSet) {
+	argPr := t.Stack.pointer - argCount
+	args := []*Pointer{}
+	names := []string{}
+	types := []uint8{}
+	expanded := false
+
+	for i := 0; i < argCount; i++ {
+		arg := t.Stack.data[argPr+i]
+
+		if arr, ok := arg.Target.(*ArrayObject); ok && arr.splat {
+			// The flag only lives until the call, so `foo(*a)` won't affect later usages of `a`
+			arr.splat = false
+			expanded = true
+
+			for _, elem := range arr.Elements {
+				args = append(args, &Pointer{Target: elem})
+				names = append(names, "")
+				types = append(types, bytecode.NormalArg)
+			}
+
+			continue
+		}
+
+		var name string
+		argType := bytecode.NormalArg
 
-	if arr, ok := t.Stack.top().Target.(*ArrayObject); ok && arr.splat {
-		// Pop array
-		t.Stack.Pop()
-		// Can't count array self, only the number of array elements
-		argCount += len(arr.Elements)
-		for _, elem := range arr.Elements {
-			t.Stack.Push(&Pointer{Target: elem})
+		if argSet != nil && i < len(argSet.Types()) {
+			name = argSet.Names()[i]
+			argType = argSet.Types()[i]
 		}
+
+		args = append(args, arg)
+		names = append(names, name)
+		types = append(types, argType)
+	}
+
+	if !expanded {
+		return argCount, argSet
+	}
+
+	t.Stack.pointer = argPr
+
+	for _, arg := range args {
+		t.Stack.Push(arg)
 	}
 
+	return len(args), bytecode.NewArgSet(names, types)
+}
+
+func (t *Thread) sendMethod(methodName string, argCount int, blockFrame *normalCallFrame, sourceLine int) {
+	var method Object
+
+	argCount, _ = t.expandSplatArguments(argCount, nil)
+
 	argPr := t.Stack.pointer - argCount - 1
 	receiverPr := argPr - 1
 	receiver := t.Stack.data[receiverPr].Target
@@ -436,6 +480,27 @@ func (t *Thread) evalMethodObject(call *callObject) {
 	t.Stack.pointer = call.argPtr()
 }
 
+// callMethod calls the receiver's method of the name with the arguments, and returns the result.
+// It runs both builtin methods and the methods defined in Goby, so builtins can call methods that users override.
+func (t *Thread) callMethod(receiver Object, methodName string, sourceLine int, args ...Object) Object {
+	switch m := receiver.findMethod(methodName).(type) {
+	case *BuiltinMethodObject:
+		return m.Fn(receiver, sourceLine, t, args, nil)
+	case *MethodObject:
+		t.Stack.Push(&Pointer{Target: receiver})
+		for _, arg := range args {
+			t.Stack.Push(&Pointer{Target: arg})
+		}
+
+		callObj := newCallObject(receiver, m, t.Stack.pointer-len(args)-1, len(args), &bytecode.ArgSet{}, nil, sourceLine)
+		t.evalMethodObject(callObj)
+
+		return t.Stack.Pop().Target
+	default:
+		return t.vm.InitErrorObject(errors.NoMethodError, sourceLine, errors.UndefinedMethod, methodName, receiver.ToString())
+	}
+}
+
 func (t *Thread) reportArgumentError(sourceLine, idealArgNumber int, methodName string, exactArgNumber int, receiverPtr int) {
 	var message string
 
@@ -448,14 +513,30 @@ func (t *Thread) reportArgumentError(sourceLine, idealArgNumber int, methodName
 	t.setErrorObject(receiverPtr, receiverPtr+1, errors.ArgumentError, sourceLine, message, idealArgNumber, methodName, exactArgNumber)
 }
 
+// initErrorObject initializes an error object traced from the thread's own call frames
+func (t *Thread) initErrorObject(errorType string, sourceLine int, format string, args ...interface{}) *Error {
+	cf := t.callFrameStack.top()
+
+	switch f := cf.(type) {
+	case *normalCallFrame:
+		// If program counter is 0 means we need to trace back to previous call frame
+		if f.pc == 0 {
+			t.callFrameStack.pop()
+			cf = t.callFrameStack.top()
+		}
+	}
+
+	return t.vm.newErrorObject(cf.FileName(), errorType, sourceLine, format, args...)
+}
+
 func (t *Thread) pushErrorObject(errorType string, sourceLine int, format string, args ...interface{}) {
-	err := t.vm.InitErrorObject(errorType, sourceLine, format, args...)
+	err := t.initErrorObject(errorType, sourceLine, format, args...)
 	t.Stack.Push(&Pointer{Target: err})
 	panic(err.Message())
 }
 
 func (t *Thread) setErrorObject(receiverPtr, sp int, errorType string, sourceLine int, format string, args ...interface{}) {
-	err := t.vm.InitErrorObject(errorType, sourceLine, format, args...)
+	err := t.initErrorObject(errorType, sourceLine, format, args...)
 	t.Stack.Set(receiverPtr, &Pointer{Target: err})
 	t.Stack.pointer = sp
 	panic(err.Message())
@@ -0,0 +1,221 @@
+package vm
+
+import (
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// ThreadObject represents a block running on its own goroutine, which is created by `Thread.new` or `thread`.
+// `join` waits for the block to finish and returns the block's result.
+//
+// ```ruby
+// t = Thread.new(20) do |n|
+//   n * 2
+// end
+// t.join #=> 40
+// ```
+//
+// Each thread has its own call frames and stack, but the local variables outside the block are shared with other threads.
+// Mutating the same object from several threads is not safe, so let each thread work on its own objects,
+// and pass the results with `join` or a `Channel` instead.
+//
+// ```ruby
+// a = []
+// b = []
+// t1 = thread do
+//   a.push(1)
+// end
+// t2 = thread do
+//   b.push(2)
+// end
+// t1.join
+// t2.join
+// a + b #=> [1, 2]
+// ```
+//
+// If the block raises an error, the error is raised again when the thread is joined.
+type ThreadObject struct {
+	*BaseObj
+	done   chan struct{}
+	result Object
+}
+
+// Class methods --------------------------------------------------------
+var builtinThreadClassMethods = []*BuiltinMethodObject{
+	{
+		// Runs the block on a new thread with the given arguments, and returns the thread.
+		//
+		// ```ruby
+		// t = Thread.new(1, 2) do |a, b|
+		//   a + b
+		// end
+		// t.join #=> 3
+		// ```
+		//
+		// @param *args [Object], block literal
+		// @return [Thread]
+		Name: "new",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if blockFrame == nil {
+				return t.vm.InitErrorObject(errors.InternalError, sourceLine, errors.CantYieldWithoutBlockFormat)
+			}
+
+			return t.spawn(blockFrame, args)
+
+		},
+	},
+}
+
+// Instance methods -----------------------------------------------------
+var builtinThreadInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns true if the thread is still running.
+		//
+		// ```ruby
+		// c = Channel.new
+		// t = thread do
+		//   c.receive
+		// end
+		// t.alive? #=> true
+		// c.deliver(1)
+		// t.join
+		// t.alive? #=> false
+		// ```
+		//
+		// @return [Boolean]
+		Name: "alive?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			select {
+			case <-receiver.(*ThreadObject).done:
+				return FALSE
+			default:
+				return TRUE
+			}
+
+		},
+	},
+	{
+		// Waits for the thread to finish, and returns the result of the block.
+		// If the block raised an error, the error is raised again.
+		//
+		// ```ruby
+		// t = thread do
+		//   10.times.reduce do |sum, n|
+		//     sum + n
+		//   end
+		// end
+		// t.join #=> 45
+		// ```
+		//
+		// @return [Object]
+		Name: "join",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*ThreadObject).join(t, args, sourceLine)
+
+		},
+	},
+	{
+		// Same as `join`.
+		//
+		// @return [Object]
+		Name: "value",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*ThreadObject).join(t, args, sourceLine)
+
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initThreadObject() *ThreadObject {
+	return &ThreadObject{
+		BaseObj: &BaseObj{class: vm.TopLevelClass(classes.ThreadClass)},
+		done:    make(chan struct{}),
+	}
+}
+
+func (vm *VM) initThreadClass() *RClass {
+	tc := vm.initializeClass(classes.ThreadClass)
+	tc.setBuiltinMethods(builtinThreadClassMethods, true)
+	tc.setBuiltinMethods(builtinThreadInstanceMethods, false)
+	return tc
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the result of the block, or nil if the thread is still running
+func (th *ThreadObject) Value() interface{} {
+	select {
+	case <-th.done:
+		return th.result
+	default:
+		return nil
+	}
+}
+
+// ToString returns the object's name as the string format
+func (th *ThreadObject) ToString() string {
+	return "#<Thread>"
+}
+
+// Inspect delegates to ToString
+func (th *ThreadObject) Inspect() string {
+	return th.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (th *ThreadObject) ToJSON(t *Thread) string {
+	return "\"" + th.ToString() + "\""
+}
+
+// join waits for the thread and returns the result of the block; common to `join` and `value`
+func (th *ThreadObject) join(t *Thread, args []Object, sourceLine int) Object {
+	if len(args) != 0 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+	}
+
+	<-th.done
+
+	return th.result
+}
+
+// Other helper functions -----------------------------------------------
+
+// spawn runs the block with the arguments on a new thread, and returns the thread; common to `Thread.new` and `thread`.
+// An error raised in the block is kept as the result, instead of crashing the whole program.
+func (t *Thread) spawn(blockFrame *normalCallFrame, args []Object) *ThreadObject {
+	th := t.vm.initThreadObject()
+	newT := t.vm.newThread()
+	th.result = NULL
+
+	go func() {
+		defer close(th.done)
+		defer func() {
+			if r := recover(); r != nil {
+				err, ok := r.(*Error)
+				if !ok {
+					panic(r)
+				}
+				th.result = err
+			}
+		}()
+
+		// An empty block leaves nothing on the stack
+		if result := newT.builtinMethodYield(blockFrame, args...); result != nil {
+			th.result = result.Target
+		}
+	}()
+
+	// We need to pop this frame from the current thread manually,
+	// because the block's 'leave' instruction is running on other goroutine
+	t.callFrameStack.pop()
+
+	return th
+}
@@ -0,0 +1,511 @@
+package vm
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// TimeObject represents a point of time with nanosecond precision.
+// `Time.now` returns the current time, and `Time.at` creates a time from the seconds since the Unix epoch.
+//
+// ```ruby
+// t = Time.at(0).utc
+// t.year                    #=> 1970
+// (t + 90).strftime("%H:%M") #=> "00:01"
+// ```
+//
+// - `Time.new` is not supported.
+type TimeObject struct {
+	*BaseObj
+	value time.Time
+}
+
+// Class methods --------------------------------------------------------
+var builtinTimeClassMethods = []*BuiltinMethodObject{
+	{
+		// Returns the time of the given seconds since the Unix epoch in the local time zone.
+		// A Float can be passed for the fractional seconds.
+		//
+		// ```ruby
+		// Time.at(0).utc.to_s        #=> "1970-01-01 00:00:00 +0000"
+		// Time.at(1.5).utc.strftime("%S.%L") #=> "01.500"
+		// ```
+		//
+		// @param seconds [Integer/Float]
+		// @return [Time]
+		Name: "at",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			switch s := args[0].(type) {
+			case *IntegerObject:
+				return t.vm.initTimeObject(time.Unix(int64(s.value), 0))
+			case *FloatObject:
+				sec, frac := math.Modf(s.value)
+				return t.vm.initTimeObject(time.Unix(int64(sec), int64(frac*float64(time.Second))))
+			default:
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+		},
+	},
+	{
+		Name: "new",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.InitNoMethodError(sourceLine, "new", receiver)
+
+		},
+	},
+	{
+		// Returns the current time.
+		//
+		// ```ruby
+		// Time.now.year #=> 2018
+		// ```
+		//
+		// @return [Time]
+		Name: "now",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			return t.vm.initTimeObject(t.vm.clock())
+
+		},
+	},
+}
+
+// Instance methods -----------------------------------------------------
+var builtinTimeInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns a new time that is the given seconds after the receiver.
+		//
+		// ```ruby
+		// t = Time.at(0).utc
+		// (t + 60).to_s   #=> "1970-01-01 00:01:00 +0000"
+		// (t + 0.5).to_s  #=> "1970-01-01 00:00:00 +0000"
+		// ```
+		//
+		// @param seconds [Integer/Float]
+		// @return [Time]
+		Name: "+",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			d, ok := toDuration(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return t.vm.initTimeObject(receiver.(*TimeObject).value.Add(d))
+
+		},
+	},
+	{
+		// Returns a new time that is the given seconds before the receiver.
+		// If a Time is given, returns the difference between the two times in seconds as a Float.
+		//
+		// ```ruby
+		// t = Time.at(60).utc
+		// (t - 60).to_i           #=> 0
+		// t - Time.at(0)          #=> 60.0
+		// ```
+		//
+		// @param seconds [Integer/Float/Time]
+		// @return [Time/Float]
+		Name: "-",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			tm := receiver.(*TimeObject).value
+
+			if other, ok := args[0].(*TimeObject); ok {
+				return t.vm.initFloatObject(tm.Sub(other.value).Seconds())
+			}
+
+			d, ok := toDuration(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric or Time", args[0].Class().Name)
+			}
+
+			return t.vm.initTimeObject(tm.Add(-d))
+
+		},
+	},
+	{
+		// Compares the time with another time, and returns -1, 0 or 1.
+		// Returns nil if the Object is not a Time.
+		//
+		// ```ruby
+		// Time.at(0) <=> Time.at(1) #=> -1
+		// Time.at(0) <=> 0          #=> nil
+		// ```
+		//
+		// @param time [Time]
+		// @return [Integer]
+		Name: "<=>",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			other, ok := args[0].(*TimeObject)
+			if !ok {
+				return NULL
+			}
+
+			tm := receiver.(*TimeObject).value
+
+			switch {
+			case tm.Before(other.value):
+				return t.vm.InitIntegerObject(-1)
+			case tm.After(other.value):
+				return t.vm.InitIntegerObject(1)
+			default:
+				return t.vm.InitIntegerObject(0)
+			}
+
+		},
+	},
+	{
+		// Returns true if the two times are the same point of time, regardless of their time zones.
+		//
+		// ```ruby
+		// Time.at(0) == Time.at(0).utc #=> true
+		// Time.at(0) == 0              #=> false
+		// ```
+		//
+		// @param time [Time]
+		// @return [Boolean]
+		Name: "==",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			other, ok := args[0].(*TimeObject)
+			if !ok {
+				return FALSE
+			}
+
+			return toBooleanObject(receiver.(*TimeObject).value.Equal(other.value))
+
+		},
+	},
+	{
+		// Returns the day of the month (1..31).
+		//
+		// ```ruby
+		// Time.at(0).utc.day #=> 1
+		// ```
+		//
+		// @return [Integer]
+		Name: "day",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*TimeObject).field(t, args, sourceLine, time.Time.Day)
+
+		},
+	},
+	{
+		// Returns the hour of the day (0..23).
+		//
+		// ```ruby
+		// Time.at(3600).utc.hour #=> 1
+		// ```
+		//
+		// @return [Integer]
+		Name: "hour",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*TimeObject).field(t, args, sourceLine, time.Time.Hour)
+
+		},
+	},
+	{
+		// Returns the minute of the hour (0..59).
+		//
+		// ```ruby
+		// Time.at(60).utc.min #=> 1
+		// ```
+		//
+		// @return [Integer]
+		Name: "min",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*TimeObject).field(t, args, sourceLine, time.Time.Minute)
+
+		},
+	},
+	{
+		// Returns the month of the year (1..12).
+		//
+		// ```ruby
+		// Time.at(0).utc.month #=> 1
+		// ```
+		//
+		// @return [Integer]
+		Name: "month",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*TimeObject).field(t, args, sourceLine, func(tm time.Time) int {
+				return int(tm.Month())
+			})
+
+		},
+	},
+	{
+		// Returns the second of the minute (0..59).
+		//
+		// ```ruby
+		// Time.at(61).utc.sec #=> 1
+		// ```
+		//
+		// @return [Integer]
+		Name: "sec",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*TimeObject).field(t, args, sourceLine, time.Time.Second)
+
+		},
+	},
+	{
+		// Formats the time with the directives in the given format string.
+		// Characters other than the directives are copied as they are, and unknown directives are kept as is.
+		//
+		// - `%Y`: year with century, `%y`: year without century (00..99), `%C`: century
+		// - `%m`: month (01..12), `%B`: full month name, `%b`: abbreviated month name
+		// - `%d`: day of the month (01..31), `%e`: day of the month padded with a space, `%j`: day of the year (001..366)
+		// - `%H`: hour (00..23), `%I`: hour (01..12), `%p`: "AM" or "PM"
+		// - `%M`: minute (00..59), `%S`: second (00..59), `%L`: millisecond (000..999), `%N`: nanosecond
+		// - `%A`: full weekday name, `%a`: abbreviated weekday name, `%u`: weekday (1..7, Monday is 1), `%w`: weekday (0..6, Sunday is 0)
+		// - `%z`: time zone offset like "+0900", `%Z`: time zone abbreviation, `%s`: seconds since the Unix epoch
+		// - `%F`: same as "%Y-%m-%d", `%T`: same as "%H:%M:%S", `%D`: same as "%m/%d/%y", `%R`: same as "%H:%M"
+		// - `%%`: a literal "%"
+		//
+		// ```ruby
+		// t = Time.at(1500000000).utc
+		// t.strftime("%Y-%m-%d %H:%M:%S")   #=> "2017-07-14 02:40:00"
+		// t.strftime("%a, %d %b %Y")        #=> "Fri, 14 Jul 2017"
+		// t.strftime("%I:%M %p")            #=> "02:40 AM"
+		// ```
+		//
+		// @param format [String]
+		// @return [String]
+		Name: "strftime",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 1 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 1, len(args))
+			}
+
+			format, ok := args[0].(*StringObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+			}
+
+			return t.vm.InitStringObject(strftime(receiver.(*TimeObject).value, format.value))
+
+		},
+	},
+	{
+		// Returns the seconds since the Unix epoch.
+		//
+		// ```ruby
+		// Time.at(1500000000).to_i #=> 1500000000
+		// ```
+		//
+		// @return [Integer]
+		Name: "to_i",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*TimeObject).field(t, args, sourceLine, func(tm time.Time) int {
+				return int(tm.Unix())
+			})
+
+		},
+	},
+	{
+		// Returns a new time of the same point of time in UTC.
+		//
+		// ```ruby
+		// Time.at(0).utc.to_s #=> "1970-01-01 00:00:00 +0000"
+		// ```
+		//
+		// @return [Time]
+		Name: "utc",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+			}
+
+			return t.vm.initTimeObject(receiver.(*TimeObject).value.UTC())
+
+		},
+	},
+	{
+		// Returns the year.
+		//
+		// ```ruby
+		// Time.at(0).utc.year #=> 1970
+		// ```
+		//
+		// @return [Integer]
+		Name: "year",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return receiver.(*TimeObject).field(t, args, sourceLine, time.Time.Year)
+
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initTimeObject(value time.Time) *TimeObject {
+	return &TimeObject{
+		BaseObj: &BaseObj{class: vm.TopLevelClass(classes.TimeClass)},
+		value:   value,
+	}
+}
+
+func (vm *VM) initTimeClass() *RClass {
+	tc := vm.initializeClass(classes.TimeClass)
+	tc.setBuiltinMethods(builtinTimeInstanceMethods, false)
+	tc.setBuiltinMethods(builtinTimeClassMethods, true)
+	return tc
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the object
+func (tm *TimeObject) Value() interface{} {
+	return tm.value
+}
+
+// ToString returns the time in the format of "2006-01-02 15:04:05 -0700"
+func (tm *TimeObject) ToString() string {
+	return tm.value.Format("2006-01-02 15:04:05 -0700")
+}
+
+// Inspect delegates to ToString
+func (tm *TimeObject) Inspect() string {
+	return tm.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (tm *TimeObject) ToJSON(t *Thread) string {
+	return "\"" + tm.ToString() + "\""
+}
+
+// field returns the Integer of the time's field taken by the getter; common to the methods like `year` and `day`
+func (tm *TimeObject) field(t *Thread, args []Object, sourceLine int, getter func(time.Time) int) Object {
+	if len(args) != 0 {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.WrongNumberOfArgument, 0, len(args))
+	}
+
+	return t.vm.InitIntegerObject(getter(tm.value))
+}
+
+// Other helper functions -----------------------------------------------
+
+// toDuration converts the seconds given as an Integer or a Float to a duration
+func toDuration(seconds Object) (time.Duration, bool) {
+	switch s := seconds.(type) {
+	case *IntegerObject:
+		return time.Duration(s.value) * time.Second, true
+	case *FloatObject:
+		return time.Duration(s.value * float64(time.Second)), true
+	default:
+		return 0, false
+	}
+}
+
+// strftime formats the time with the directives of Ruby's Time#strftime
+func strftime(tm time.Time, format string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			b.WriteByte(format[i])
+			continue
+		}
+
+		i++
+
+		switch format[i] {
+		case 'Y':
+			b.WriteString(strconv.Itoa(tm.Year()))
+		case 'y':
+			fmt.Fprintf(&b, "%02d", tm.Year()%100)
+		case 'C':
+			fmt.Fprintf(&b, "%02d", tm.Year()/100)
+		case 'm':
+			fmt.Fprintf(&b, "%02d", int(tm.Month()))
+		case 'B':
+			b.WriteString(tm.Month().String())
+		case 'b', 'h':
+			b.WriteString(tm.Month().String()[:3])
+		case 'd':
+			fmt.Fprintf(&b, "%02d", tm.Day())
+		case 'e':
+			fmt.Fprintf(&b, "%2d", tm.Day())
+		case 'j':
+			fmt.Fprintf(&b, "%03d", tm.YearDay())
+		case 'H':
+			fmt.Fprintf(&b, "%02d", tm.Hour())
+		case 'I':
+			fmt.Fprintf(&b, "%02d", (tm.Hour()+11)%12+1)
+		case 'p':
+			if tm.Hour() < 12 {
+				b.WriteString("AM")
+			} else {
+				b.WriteString("PM")
+			}
+		case 'M':
+			fmt.Fprintf(&b, "%02d", tm.Minute())
+		case 'S':
+			fmt.Fprintf(&b, "%02d", tm.Second())
+		case 'L':
+			fmt.Fprintf(&b, "%03d", tm.Nanosecond()/int(time.Millisecond))
+		case 'N':
+			fmt.Fprintf(&b, "%09d", tm.Nanosecond())
+		case 'A':
+			b.WriteString(tm.Weekday().String())
+		case 'a':
+			b.WriteString(tm.Weekday().String()[:3])
+		case 'u':
+			fmt.Fprintf(&b, "%d", (int(tm.Weekday())+6)%7+1)
+		case 'w':
+			fmt.Fprintf(&b, "%d", int(tm.Weekday()))
+		case 'z':
+			b.WriteString(tm.Format("-0700"))
+		case 'Z':
+			b.WriteString(tm.Format("MST"))
+		case 's':
+			b.WriteString(strconv.FormatInt(tm.Unix(), 10))
+		case 'F':
+			b.WriteString(strftime(tm, "%Y-%m-%d"))
+		case 'T':
+			b.WriteString(strftime(tm, "%H:%M:%S"))
+		case 'D':
+			b.WriteString(strftime(tm, "%m/%d/%y"))
+		case 'R':
+			b.WriteString(strftime(tm, "%H:%M"))
+		case '%':
+			b.WriteByte('%')
+		default:
+			b.WriteByte('%')
+			b.WriteByte(format[i])
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,182 @@
+package vm
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeNowMethod(t *testing.T) {
+	frozen := time.Date(2018, time.March, 4, 5, 6, 7, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Time.now.year`, 2018},
+		{`Time.now.month`, 3},
+		{`Time.now.day`, 4},
+		{`Time.now.hour`, 5},
+		{`Time.now.min`, 6},
+		{`Time.now.sec`, 7},
+		{`Time.now.to_i`, int(frozen.Unix())},
+		{`Time.now.to_s`, "2018-03-04 05:06:07 +0000"},
+		{`Time.now == Time.now`, true},
+		{`Time.now.class.name`, "Time"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		v.SetClock(func() time.Time { return frozen })
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestTimeAtMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Time.at(0).to_i`, 0},
+		{`Time.at(1500000000).to_i`, 1500000000},
+		{`Time.at(0).utc.to_s`, "1970-01-01 00:00:00 +0000"},
+		{`Time.at(1500000000).utc.year`, 2017},
+		{`Time.at(1500000000).utc.month`, 7},
+		{`Time.at(1500000000).utc.day`, 14},
+		{`Time.at(1500000000).utc.hour`, 2},
+		{`Time.at(1500000000).utc.min`, 40},
+		{`Time.at(1500000000).utc.sec`, 0},
+		{`Time.at(1.5).utc.strftime("%S.%L")`, "01.500"},
+		{`Time.at(-1).utc.to_s`, "1969-12-31 23:59:59 +0000"},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestTimeAtMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Time.at`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`Time.at(1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`Time.at("1")`, "TypeError: Expect argument to be Numeric. got: String", 1},
+		{`Time.now(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`Time.new`, "NoMethodError: Undefined Method 'new' for Time", 1},
+		{`Time.at(0).year(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+		{`Time.at(0).utc(1)`, "ArgumentError: Expect 0 argument(s). got: 1", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestTimeArithmeticMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`(Time.at(0) + 60).to_i`, 60},
+		{`(Time.at(0).utc + 3661).to_s`, "1970-01-01 01:01:01 +0000"},
+		{`(Time.at(0).utc + 1.25).strftime("%S.%L")`, "01.250"},
+		{`(Time.at(60) - 30).to_i`, 30},
+		{`(Time.at(0) - 0.5).utc.strftime("%T.%L")`, "23:59:59.500"},
+		{`Time.at(90) - Time.at(30)`, 60.0},
+		{`Time.at(0) - Time.at(1.5)`, -1.5},
+		{`
+		t = Time.at(0)
+		t + 10
+		t.to_i
+		`, 0},
+		{`Time.at(0) <=> Time.at(1)`, -1},
+		{`Time.at(1) <=> Time.at(0)`, 1},
+		{`Time.at(0) <=> Time.at(0).utc`, 0},
+		{`Time.at(0) <=> 0`, nil},
+		{`Time.at(0) == Time.at(0).utc`, true},
+		{`Time.at(0) == Time.at(1)`, false},
+		{`Time.at(0) == 0`, false},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestTimeArithmeticMethodsFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Time.at(0) + "1"`, "TypeError: Expect argument to be Numeric. got: String", 1},
+		{`Time.at(0) + Time.at(0)`, "TypeError: Expect argument to be Numeric. got: Time", 1},
+		{`Time.at(0) - "1"`, "TypeError: Expect argument to be Numeric or Time. got: String", 1},
+		{`Time.at(0).send("+", 1, 2)`, "ArgumentError: Expect 1 argument(s). got: 2", 2},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestTimeStrftimeMethod(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`Time.at(1500000000).utc.strftime("%Y-%m-%d %H:%M:%S")`, "2017-07-14 02:40:00"},
+		{`Time.at(1500000000).utc.strftime("%y %C")`, "17 20"},
+		{`Time.at(1500000000).utc.strftime("%B %b %h")`, "July Jul Jul"},
+		{`Time.at(1500000000).utc.strftime("%A %a %u %w")`, "Friday Fri 5 5"},
+		{`Time.at(1500000000).utc.strftime("%j")`, "195"},
+		{`Time.at(1500000000).utc.strftime("%I:%M %p")`, "02:40 AM"},
+		{`(Time.at(1500000000).utc + 43200).strftime("%I:%M %p")`, "02:40 PM"},
+		{`Time.at(0).utc.strftime("%I %p")`, "12 AM"},
+		{`Time.at(0).utc.strftime("%d|%e")`, "01| 1"},
+		{`Time.at(0.123).utc.strftime("%L %N")`, "123 123000000"},
+		{`Time.at(1500000000).utc.strftime("%z %Z")`, "+0000 UTC"},
+		{`Time.at(1500000000).strftime("%s")`, "1500000000"},
+		{`Time.at(1500000000).utc.strftime("%F %T")`, "2017-07-14 02:40:00"},
+		{`Time.at(1500000000).utc.strftime("%D %R")`, "07/14/17 02:40"},
+		{`Time.at(0).utc.strftime("100%% %Q %")`, "100% %Q %"},
+		{`Time.at(0).utc.strftime("")`, ""},
+	}
+
+	for i, tt := range tests {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
+func TestTimeStrftimeMethodFail(t *testing.T) {
+	testsFail := []errorTestCase{
+		{`Time.at(0).strftime`, "ArgumentError: Expect 1 argument(s). got: 0", 1},
+		{`Time.at(0).strftime("%Y", "%m")`, "ArgumentError: Expect 1 argument(s). got: 2", 1},
+		{`Time.at(0).strftime(1)`, "TypeError: Expect argument to be String. got: Integer", 1},
+	}
+
+	for i, tt := range testsFail {
+		v := initTestVM()
+		evaluated := v.testEval(t, tt.input, getFilename())
+		checkErrorMsg(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, tt.expectedCFP)
+		v.checkSP(t, i, 1)
+	}
+}
@@ -2,13 +2,16 @@ package vm
 
 import (
 	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/goby-lang/goby/compiler"
 	"github.com/goby-lang/goby/compiler/bytecode"
@@ -76,12 +79,33 @@ type VM struct {
 	libFiles []string
 
 	threadCount int64
+
+	// random is the default source of randomness for methods like Array#sample and Random.rand.
+	// It can be seeded by SetRandomSeed or replaced by SetRandom to get reproducible results.
+	random     *rand.Rand
+	randomLock sync.Mutex
+
+	// output is where methods like `puts` and `print` write to. Defaults to os.Stdout.
+	output io.Writer
+
+	// clock returns the current time for `Time.now`. Defaults to time.Now.
+	clock func() time.Time
+
+	// httpTransport sends the requests of `Net::HTTP`. Defaults to http.DefaultTransport when nil.
+	httpTransport http.RoundTripper
+
+	// env replaces the process's environment variables for `ENV` when it's set by SetEnv.
+	env     map[string]string
+	envLock sync.RWMutex
 }
 
 // New initializes a vm to initialize state and returns it.
 func New(fileDir string, args []string) (vm *VM, e error) {
 	vm = &VM{args: args}
 	vm.mainThread.vm = vm
+	vm.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	vm.output = os.Stdout
+	vm.clock = time.Now
 	vm.threadCount++
 
 	vm.methodISIndexTables = map[filename]*isIndexTable{
@@ -244,11 +268,22 @@ func (vm *VM) initConstants() {
 		vm.initMatchDataClass(),
 		vm.initGoMapClass(),
 		vm.initDecimalClass(),
+		vm.initTimeClass(),
+		vm.initRandomClass(),
+		vm.initStringIOClass(),
+		vm.initThreadClass(),
+		vm.initMutexClass(),
+		vm.initEnvClass(),
+		vm.initComparableModule(),
 	}
 
 	// Init error classes
 	vm.initErrorClasses()
 
+	// Init builtin modules that are written in Goby
+	vm.libFiles = append(vm.libFiles, "comparable.gb")
+	vm.libFiles = append(vm.libFiles, "enumerable.gb")
+
 	for _, c := range builtinClasses {
 		vm.objectClass.setClassConstant(c)
 	}
@@ -262,15 +297,7 @@ func (vm *VM) initConstants() {
 
 	vm.objectClass.constants["ARGV"] = &Pointer{Target: vm.InitArrayObject(args)}
 
-	// Init ENV
-	envs := map[string]Object{}
-
-	for _, e := range os.Environ() {
-		pair := strings.Split(e, "=")
-		envs[pair[0]] = vm.InitStringObject(pair[1])
-	}
-
-	vm.objectClass.constants["ENV"] = &Pointer{Target: vm.InitHashObject(envs)}
+	vm.objectClass.constants["ENV"] = &Pointer{Target: vm.initEnvObject()}
 	vm.objectClass.constants["STDOUT"] = &Pointer{Target: vm.initFileObject(os.Stdout)}
 	vm.objectClass.constants["STDERR"] = &Pointer{Target: vm.initFileObject(os.Stderr)}
 	vm.objectClass.constants["STDIN"] = &Pointer{Target: vm.initFileObject(os.Stdin)}
@@ -313,11 +340,11 @@ func (vm *VM) loadConstant(name string, isModule bool) *RClass {
 	return c
 }
 
-func (vm *VM) lookupConstant(cf callFrame, constName string) (constant *Pointer) {
+func (vm *VM) lookupConstant(t *Thread, cf callFrame, constName string) (constant *Pointer) {
 	var namespace *RClass
 	var hasNamespace bool
 
-	top := vm.mainThread.Stack.top()
+	top := t.Stack.top()
 
 	if top == nil {
 		hasNamespace = false
@@ -345,6 +372,124 @@ func (vm *VM) lookupConstant(cf callFrame, constName string) (constant *Pointer)
 
 	return
 }
+
+// SetRandomSeed seeds the VM's random source, so the results of methods like Array#sample are reproducible.
+func (vm *VM) SetRandomSeed(seed int64) {
+	vm.randomLock.Lock()
+	defer vm.randomLock.Unlock()
+
+	vm.random.Seed(seed)
+}
+
+// SetRandom replaces the VM's default random source with the given generator.
+func (vm *VM) SetRandom(r *rand.Rand) {
+	vm.randomLock.Lock()
+	defer vm.randomLock.Unlock()
+
+	vm.random = r
+}
+
+// SetOutput sets the writer that methods like `puts`, `print` and `p` write to.
+func (vm *VM) SetOutput(w io.Writer) {
+	vm.output = w
+}
+
+// Output returns the writer that methods like `puts`, `print` and `p` write to.
+func (vm *VM) Output() io.Writer {
+	return vm.output
+}
+
+// SetClock sets the clock that `Time.now` reads the current time from, so the time can be frozen in tests.
+func (vm *VM) SetClock(clock func() time.Time) {
+	vm.clock = clock
+}
+
+// SetHTTPTransport sets the transport that `Net::HTTP` sends requests with, so requests can be served without a live server in tests.
+func (vm *VM) SetHTTPTransport(transport http.RoundTripper) {
+	vm.httpTransport = transport
+}
+
+// SetEnv makes `ENV` read and write the given map instead of the process's environment variables, so tests don't depend on or change the real environment.
+func (vm *VM) SetEnv(env map[string]string) {
+	vm.envLock.Lock()
+	defer vm.envLock.Unlock()
+
+	vm.env = env
+}
+
+// putsObject writes the object to the writer with a tailing line feed; common to `puts` and `StringIO#puts`.
+// Elements of an array are written one per line, and an array that contains itself is written as `[...]`.
+func putsObject(w io.Writer, obj Object) {
+	putsElements(w, obj, map[*ArrayObject]bool{})
+}
+
+// putsElements writes the object like putsObject, skipping the arrays in visited
+func putsElements(w io.Writer, obj Object, visited map[*ArrayObject]bool) {
+	arr, ok := obj.(*ArrayObject)
+
+	if !ok {
+		fmt.Fprintln(w, obj.ToString())
+		return
+	}
+
+	if visited[arr] {
+		fmt.Fprintln(w, "[...]")
+		return
+	}
+
+	if len(arr.Elements) == 0 {
+		fmt.Fprintln(w)
+		return
+	}
+
+	visited[arr] = true
+	for _, elem := range arr.Elements {
+		putsElements(w, elem, visited)
+	}
+	delete(visited, arr)
+}
+
+// randomIntn returns a random integer in [0, n) from the VM's random source.
+func (vm *VM) randomIntn(n int) int {
+	vm.randomLock.Lock()
+	defer vm.randomLock.Unlock()
+
+	return vm.random.Intn(n)
+}
+
+// randomSample returns k different random integers in [0, n) from the VM's random source.
+// It runs the first k steps of a Fisher–Yates shuffle, and only keeps the swapped positions in a map,
+// so it takes O(k) time and space whatever n is.
+func (vm *VM) randomSample(n, k int) []int {
+	vm.randomLock.Lock()
+	defer vm.randomLock.Unlock()
+
+	swapped := map[int]int{}
+	at := func(i int) int {
+		if v, ok := swapped[i]; ok {
+			return v
+		}
+		return i
+	}
+
+	sample := make([]int, k)
+	for i := 0; i < k; i++ {
+		j := i + vm.random.Intn(n-i)
+		sample[i] = at(j)
+		swapped[j] = at(i)
+	}
+
+	return sample
+}
+
+// randomShuffle randomly reorders n elements with the VM's random source, using swap to exchange the elements.
+func (vm *VM) randomShuffle(n int, swap func(i, j int)) {
+	vm.randomLock.Lock()
+	defer vm.randomLock.Unlock()
+
+	vm.random.Shuffle(n, swap)
+}
+
 func initTestVM() *VM {
 	fn, err := os.Getwd()
 
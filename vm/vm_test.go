@@ -160,7 +160,7 @@ func TestVM_REPLExecFail(t *testing.T) {
 			[]string{
 				`raise ArgumentError`,
 			},
-			fmt.Sprintf("InternalError: '%s'", errors.ArgumentError),
+			fmt.Sprintf("%s: '%s'", errors.ArgumentError, errors.ArgumentError),
 		},
 		{
 			[]string{
@@ -250,6 +250,34 @@ func TestLoadingGobyLibraryFail(t *testing.T) {
 	}
 }
 
+func TestARGVConstant(t *testing.T) {
+	tests := []struct {
+		args     []string
+		input    string
+		expected interface{}
+	}{
+		{[]string{"foo", "bar"}, `ARGV[0]`, "foo"},
+		{[]string{"foo", "bar"}, `ARGV[1]`, "bar"},
+		{[]string{"foo", "bar"}, `ARGV.length`, 2},
+		{[]string{}, `ARGV.length`, 0},
+		{[]string{}, `ARGV[0]`, nil},
+		{[]string{"1"}, `ARGV[0].class.name`, "String"},
+	}
+
+	for i, tt := range tests {
+		v, err := New(".", tt.args)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		evaluated := v.testEval(t, tt.input, getFilename())
+		VerifyExpected(t, i, evaluated, tt.expected)
+		v.checkCFP(t, i, 0)
+		v.checkSP(t, i, 1)
+	}
+}
+
 func (v *VM) checkCFP(t *testing.T, index, expectedCFP int) {
 	t.Helper()
 	if v.mainThread.callFrameStack.pointer != expectedCFP {